@@ -0,0 +1,78 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// minBlindIndexKeySize is the minimum size of blind index keys, the size of the hash.
+const minBlindIndexKeySize = 32
+
+// OptionBlindIndex is a function type used for applying options to the BlindIndex.
+type OptionBlindIndex func(*BlindIndex)
+
+// WithNormalizer sets the function applied to the values before hashing, so
+// equal values written differently have the same index.
+// Defaults to strings.TrimSpace and strings.ToLower.
+func WithNormalizer(normalize func(string) string) OptionBlindIndex {
+	return func(b *BlindIndex) {
+		b.normalize = normalize
+	}
+}
+
+// WithContext sets a context, e.g. the column name, hashed with every value so
+// the same value has different indexes in different columns.
+func WithContext(context string) OptionBlindIndex {
+	return func(b *BlindIndex) {
+		b.context = context
+	}
+}
+
+// BlindIndex computes a deterministic HMAC-SHA256 of values, stored next to
+// their ciphertext to search them by equality without decrypting.
+// Its key must differ from the encryption keys and can not be rotated without
+// recomputing the stored indexes.
+type BlindIndex struct {
+	key       []byte
+	context   string
+	normalize func(string) string
+}
+
+// normalize is the default normalizer of BlindIndex.
+func normalize(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// NewBlindIndex creates a BlindIndex with a key of at least 32 bytes.
+func NewBlindIndex(key []byte, options ...OptionBlindIndex) (*BlindIndex, error) {
+	if len(key) < minBlindIndexKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	b := &BlindIndex{
+		key:       key,
+		normalize: normalize,
+	}
+	// Apply any passed options to the blind index.
+	for _, o := range options {
+		o(b)
+	}
+	return b, nil
+}
+
+// Sum returns the hex encoded index of the value, e.g. to store it in an
+// indexed column and to look it up with "WHERE email_index = ?".
+func (b *BlindIndex) Sum(value string) string {
+	mac := hmac.New(sha256.New, b.key)
+	if b.context != "" {
+		mac.Write([]byte(b.context))
+		mac.Write([]byte{0})
+	}
+	if b.normalize != nil {
+		value = b.normalize(value)
+	}
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,99 @@
+package encryption
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrNoKeyring is returned by EncryptedString when SetDefaultKeyring was not called.
+var ErrNoKeyring = errors.New("encryption: default keyring is not set")
+
+// defaultKeyring is the keyring used by EncryptedString.
+var defaultKeyring atomic.Pointer[Keyring]
+
+// SetDefaultKeyring sets the keyring used by EncryptedString, e.g. at startup.
+func SetDefaultKeyring(k *Keyring) {
+	defaultKeyring.Store(k)
+}
+
+// DefaultKeyring returns the keyring used by EncryptedString, or nil.
+func DefaultKeyring() *Keyring {
+	return defaultKeyring.Load()
+}
+
+// EncryptedString is a string stored encrypted in the database with the
+// default keyring. It holds the plaintext in Go and in JSON.
+//
+// Empty strings are stored as is, and database NULL scans to an empty string.
+type EncryptedString string
+
+// Value encrypts the string with the default keyring, implementing driver.Valuer.
+func (s EncryptedString) Value() (driver.Value, error) {
+	if s == "" {
+		return "", nil
+	}
+
+	k := DefaultKeyring()
+	if k == nil {
+		return nil, ErrNoKeyring
+	}
+	return k.EncryptString(string(s))
+}
+
+// Scan decrypts a database value with the default keyring, implementing sql.Scanner.
+func (s *EncryptedString) Scan(src interface{}) error {
+	var ciphertext string
+	switch v := src.(type) {
+	case nil:
+		*s = ""
+		return nil
+	case string:
+		ciphertext = v
+	case []byte:
+		ciphertext = string(v)
+	default:
+		return fmt.Errorf("encryption: can not scan %T into EncryptedString", src)
+	}
+
+	if ciphertext == "" {
+		*s = ""
+		return nil
+	}
+
+	k := DefaultKeyring()
+	if k == nil {
+		return ErrNoKeyring
+	}
+	plaintext, err := k.DecryptString(ciphertext)
+	if err != nil {
+		return err
+	}
+	*s = EncryptedString(plaintext)
+	return nil
+}
+
+// MarshalJSON encodes the plaintext as a JSON string.
+func (s EncryptedString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON decodes a JSON string, null decodes to an empty string.
+func (s *EncryptedString) UnmarshalJSON(d []byte) error {
+	var v *string
+	if err := json.Unmarshal(d, &v); err != nil {
+		return err
+	}
+	*s = ""
+	if v != nil {
+		*s = EncryptedString(*v)
+	}
+	return nil
+}
+
+// String returns the plaintext.
+func (s EncryptedString) String() string {
+	return string(s)
+}
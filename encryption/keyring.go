@@ -0,0 +1,248 @@
+// Package encryption encrypts fields at rest, e.g. NIK, phone numbers and
+// emails, with AES-256-GCM and a keyring for key rotation, and computes blind
+// indexes to search the encrypted values.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// KeySize is the size of the AES-256 keys, in bytes.
+const KeySize = 32
+
+// version is the prefix of the ciphertext format "v1:<key id>:<base64url nonce and sealed data>".
+const version = "v1"
+
+// Errors returned by the Keyring.
+var (
+	ErrInvalidKey   = errors.New("encryption: key must be 32 bytes")
+	ErrInvalidKeyID = errors.New("encryption: key id must not be empty or contain ':'")
+	ErrUnknownKey   = errors.New("encryption: unknown key id")
+	ErrNoPrimaryKey = errors.New("encryption: keyring has no primary key")
+	ErrMalformed    = errors.New("encryption: malformed ciphertext")
+	ErrDecrypt      = errors.New("encryption: message authentication failed")
+)
+
+// GenerateKey generates a random AES-256 key.
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// KeyFromBase64 decodes a key stored in standard base64, e.g. in an environment variable.
+func KeyFromBase64(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// Keyring encrypts with its primary key and decrypts with any of its keys,
+// selected by the key ID written in the ciphertext, so keys can be rotated
+// without re-encrypting the stored values at once.
+// A Keyring is safe for concurrent use. Its zero value has no key: Encrypt
+// returns ErrNoPrimaryKey until a key is added with Rotate.
+type Keyring struct {
+	mu      sync.RWMutex
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring creates a Keyring with the primary key.
+//
+// Parameters:
+// - id: The key ID, which must not be empty or contain ':'.
+// - key: The 32 bytes AES-256 key.
+//
+// Returns:
+// - The keyring.
+// - An error if the key ID or the key is invalid.
+func NewKeyring(id string, key []byte) (*Keyring, error) {
+	k := &Keyring{keys: map[string]cipher.AEAD{}}
+	if err := k.Rotate(id, key); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// AddKey adds a key that only decrypts, e.g. a previous primary key.
+func (k *Keyring) AddKey(id string, key []byte) error {
+	aead, err := newAEAD(id, key)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.setKey(id, aead)
+	return nil
+}
+
+// Rotate adds the key and makes it the primary key. The previous keys still
+// decrypt the values they encrypted, see NeedsRotation to re-encrypt them.
+func (k *Keyring) Rotate(id string, key []byte) error {
+	aead, err := newAEAD(id, key)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.setKey(id, aead)
+	k.primary = id
+	return nil
+}
+
+// setKey adds the cipher of a key, creating the map of a zero Keyring.
+// The caller must hold the write lock.
+func (k *Keyring) setKey(id string, aead cipher.AEAD) {
+	if k.keys == nil {
+		k.keys = map[string]cipher.AEAD{}
+	}
+	k.keys[id] = aead
+}
+
+// RemoveKey removes a key once no value is encrypted with it anymore.
+// The primary key can not be removed.
+func (k *Keyring) RemoveKey(id string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if id != k.primary {
+		delete(k.keys, id)
+	}
+}
+
+// Encrypt encrypts the plaintext with the primary key.
+//
+// The associated data, e.g. the table and column name or the row ID, is
+// authenticated but not encrypted: decrypting needs the same associated data,
+// so a ciphertext can not be copied to another column or row. It can be nil.
+//
+// Parameters:
+// - plaintext: The data to encrypt.
+// - associatedData: The data bound to the ciphertext, or nil.
+//
+// Returns:
+// - The ciphertext in the format "v1:<key id>:<base64url>".
+// - ErrNoPrimaryKey if the keyring has no primary key, or an error if the system has no entropy for the nonce.
+func (k *Keyring) Encrypt(plaintext, associatedData []byte) (string, error) {
+	k.mu.RLock()
+	id, aead := k.primary, k.keys[k.primary]
+	k.mu.RUnlock()
+	if aead == nil {
+		return "", ErrNoPrimaryKey
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, associatedData)
+	return version + ":" + id + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a ciphertext of Encrypt with the key of its key ID.
+//
+// Parameters:
+// - ciphertext: The ciphertext in the format "v1:<key id>:<base64url>".
+// - associatedData: The associated data given to Encrypt.
+//
+// Returns:
+// - The plaintext.
+// - ErrMalformed, ErrUnknownKey, or ErrDecrypt if the ciphertext or the associated data were altered.
+func (k *Keyring) Decrypt(ciphertext string, associatedData []byte) ([]byte, error) {
+	id, sealed, err := parseCiphertext(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	aead, ok := k.keys[id]
+	k.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformed
+	}
+	nonce, sealed := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// EncryptString encrypts a string without associated data.
+func (k *Keyring) EncryptString(plaintext string) (string, error) {
+	return k.Encrypt([]byte(plaintext), nil)
+}
+
+// DecryptString decrypts a ciphertext of EncryptString.
+func (k *Keyring) DecryptString(ciphertext string) (string, error) {
+	plaintext, err := k.Decrypt(ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether the ciphertext is encrypted with another key
+// than the primary key and should be re-encrypted.
+func (k *Keyring) NeedsRotation(ciphertext string) bool {
+	id, _, err := parseCiphertext(ciphertext)
+	if err != nil {
+		return false
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return id != k.primary
+}
+
+// IsEncrypted reports whether the value has the ciphertext format of Encrypt.
+func IsEncrypted(value string) bool {
+	_, _, err := parseCiphertext(value)
+	return err == nil
+}
+
+// newAEAD creates the AES-256-GCM cipher of a key.
+func newAEAD(id string, key []byte) (cipher.AEAD, error) {
+	if id == "" || strings.Contains(id, ":") {
+		return nil, ErrInvalidKeyID
+	}
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// parseCiphertext splits a ciphertext into its key ID and its nonce and sealed data.
+func parseCiphertext(ciphertext string) (string, []byte, error) {
+	parts := strings.Split(ciphertext, ":")
+	if len(parts) != 3 || parts[0] != version || parts[1] == "" {
+		return "", nil, ErrMalformed
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, ErrMalformed
+	}
+	return parts[1], sealed, nil
+}
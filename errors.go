@@ -1,9 +1,104 @@
 package help
 
-import "log"
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicReporter is called with the operation name, the recovered value and the stack trace of a panic.
+type PanicReporter func(opName string, recovered interface{}, stack []byte)
+
+var (
+	// panicReporterMu guards panicReporter.
+	panicReporterMu sync.RWMutex
+	// panicReporter reports the panics recovered by PanicRecoverError and SafeGo.
+	panicReporter PanicReporter = logPanicReport
+)
+
+// PanicError is the error produced from a recovered panic.
+type PanicError struct {
+	Op    string      // Op is the name of the operation that panicked.
+	Value interface{} // Value is the value passed to panic.
+	Stack []byte      // Stack is the stack trace captured when the panic was recovered.
+}
+
+// Error returns the string representation of the panic.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%v panic recover: %v", e.Op, e.Value)
+}
+
+// Unwrap returns the panic value when it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
 
 func PanicRecover(opName string) {
 	if r := recover(); r != nil {
 		log.Printf("%v panic recover: %v \n", opName, r)
 	}
 }
+
+// SetPanicReporter sets the reporter called by PanicRecoverError and SafeGo.
+// By default panics are logged with log.Printf together with their stack trace.
+func SetPanicReporter(reporter PanicReporter) {
+	panicReporterMu.Lock()
+	defer panicReporterMu.Unlock()
+	panicReporter = reporter
+}
+
+// logPanicReport is the default PanicReporter, it logs the panic and its stack trace.
+func logPanicReport(opName string, recovered interface{}, stack []byte) {
+	log.Printf("%v panic recover: %v \n%s", opName, recovered, stack)
+}
+
+// reportPanic calls the configured PanicReporter.
+func reportPanic(opName string, recovered interface{}, stack []byte) {
+	panicReporterMu.RLock()
+	reporter := panicReporter
+	panicReporterMu.RUnlock()
+
+	if reporter != nil {
+		reporter(opName, recovered, stack)
+	}
+}
+
+// PanicRecoverError recovers from a panic and converts it into a *PanicError.
+//
+// It must be deferred directly. The panic is reported with its stack trace
+// through the PanicReporter and, when err is not nil, the error is assigned to
+// *err, so a function with a named error result returns it instead of nil.
+//
+// Example:
+//
+//	func Do() (err error) {
+//		defer PanicRecoverError("Do", &err)
+//		...
+//	}
+func PanicRecoverError(opName string, err *error) {
+	if r := recover(); r != nil {
+		stack := debug.Stack()
+		reportPanic(opName, r, stack)
+
+		if err != nil {
+			*err = &PanicError{
+				Op:    opName,
+				Value: r,
+				Stack: stack,
+			}
+		}
+	}
+}
+
+// SafeGo runs fn in a new goroutine that recovers and reports panics,
+// so a panic in fn cannot crash the process.
+func SafeGo(opName string, fn func()) {
+	go func() {
+		defer PanicRecoverError(opName, nil)
+		fn()
+	}()
+}
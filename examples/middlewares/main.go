@@ -0,0 +1,38 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/adamnasrudin03/go-helpers/middlewares"
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+)
+
+func main() {
+	port := "1200"
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		response_mapper.RenderJSON(w, http.StatusOK, "welcome this server")
+	})
+
+	mux.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) {
+		// curl --location 'http://localhost:1200/panic'
+		var data []string
+		log.Println(data[1]) // panic index out of range, answered with 500 Internal Server Error
+	})
+
+	handler := middlewares.RequestID()(mux)
+	handler = middlewares.Recover(
+		middlewares.WithPanicHook(func(r *http.Request, recovered interface{}, stack []byte) {
+			log.Printf("panic on %v %v: %v\n%s", r.Method, r.URL.Path, recovered, stack)
+		}),
+		middlewares.WithRepanicAbortHandler(true),
+	)(handler)
+
+	log.Printf("Server is running on port %v\n", port)
+	err := http.ListenAndServe(":"+port, handler)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	help "github.com/adamnasrudin03/go-helpers"
 	"github.com/adamnasrudin03/go-helpers/validators"
@@ -21,14 +22,29 @@ func examplePanicRecover() {
 	fmt.Println(data[1]) // panic index out of range
 }
 
+func examplePanicRecoverError() (err error) {
+	defer help.PanicRecoverError("examplePanicRecoverError", &err)
+	data := make([]teamMember, 0)
+	fmt.Println(data[1]) // panic index out of range
+	return nil
+}
+
 func main() {
 	examplePanicRecover()
 
+	err := examplePanicRecoverError()
+	fmt.Println(err) // output; examplePanicRecoverError panic recover: runtime error: index out of range [1] with length 0
+
+	help.SafeGo("exampleSafeGo", func() {
+		panic("goroutine panic") // recovered and reported, the process keeps running
+	})
+	time.Sleep(100 * time.Millisecond)
+
 	tm := teamMember{
 		FirstName: "am",
 	}
 	validate := validator.New()
-	err := validate.Struct(tm)
+	err = validate.Struct(tm)
 	if errors := err.(validator.ValidationErrors); errors != nil {
 		temp := validators.FormatErrorValidator(errors)
 		for _, v := range temp {
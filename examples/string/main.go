@@ -24,11 +24,11 @@ func examplePassCase() {
 	}
 
 	pass2 := help.GeneratePassword(8)
-	if help.PasswordIsValid(hashPass, pass2) {
+	if valid, _ := help.PasswordIsValid(hashPass, pass2); valid {
 		fmt.Println("[1] password valid")
 	}
-	if help.PasswordIsValid(hashPass, pass) {
-		fmt.Println("[2] password valid")
+	if valid, needsRehash := help.PasswordIsValid(hashPass, pass); valid {
+		fmt.Println("[2] password valid, needs rehash:", needsRehash)
 	}
 }
 
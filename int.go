@@ -2,11 +2,11 @@ package help
 
 import (
 	"math"
-	"math/rand"
 )
 
-// GenerateRandomNumber generates a random number within a specified length.
+// GenerateRandomNumber generates a random number within a specified length, with crypto/rand.
 // The length parameter determines the maximum value of the generated number.
+// It panics if the system has no entropy, see RandomNumber to handle the error.
 //
 // Parameters:
 // - length: The length of the number range.
@@ -15,17 +15,17 @@ import (
 // - A randomly generated number within the specified range.
 // see https://adamnasrudin.vercel.app/cheat-sheet/generate-random-number-using-golang
 func GenerateRandomNumber(length int) int {
-	// Generate a random number within the range of 10^length
-	// using the math/rand package.
-
 	// Calculate the maximum value of the range based on the length.
-	maxValue := int(math.Pow10(length))
+	maxValue := int64(math.Pow10(length))
 
-	// Generate a random number within the range using rand.Intn.
-	num := rand.Intn(maxValue)
+	// Generate a random number within the range.
+	num, err := RandomInt(maxValue)
+	if err != nil {
+		panic(err)
+	}
 
 	// Return the generated random number.
-	return num
+	return int(num)
 }
 
 // GetMinMaxIntArray calculates the minimum and maximum values in an array of Int.
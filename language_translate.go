@@ -42,9 +42,9 @@ func defaultTargetLang(s string) string {
 
 // Translate text from source language to target language
 // https://cloud.google.com/translate/automl/docs/reference/rest/v3/projects.locations/translateText
-func Translate(source, sourceLang, targetLang string) (string, error) {
+func Translate(source, sourceLang, targetLang string) (translated string, err error) {
 	// handle panic
-	defer PanicRecover("language-Translate")
+	defer PanicRecoverError("language-Translate", &err)
 
 	// prepare variables
 	var (
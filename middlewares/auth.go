@@ -0,0 +1,129 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+	"github.com/adamnasrudin03/go-helpers/token"
+)
+
+// TokenExtractor returns the token of a request, or an empty string when there is none.
+type TokenExtractor func(r *http.Request) string
+
+// OptionAuthenticate is a function type used for applying options to the Authenticate middleware.
+type OptionAuthenticate func(*authenticator)
+
+// authenticator holds the configuration of the Authenticate middleware.
+type authenticator struct {
+	extractor TokenExtractor // extractor reads the token of the request.
+	optional  bool           // optional lets requests without token through.
+}
+
+// WithTokenExtractor sets the function used to read the token of a request,
+// e.g. from a cookie. Defaults to BearerToken.
+func WithTokenExtractor(extractor TokenExtractor) OptionAuthenticate {
+	return func(a *authenticator) {
+		a.extractor = extractor
+	}
+}
+
+// WithOptionalToken lets requests without token through without claims in
+// their context. Requests with an invalid token are still rejected.
+func WithOptionalToken(optional bool) OptionAuthenticate {
+	return func(a *authenticator) {
+		a.optional = optional
+	}
+}
+
+// BearerToken returns the token of the "Authorization: Bearer <token>" header.
+func BearerToken(r *http.Request) string {
+	scheme, credentials, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(credentials)
+}
+
+// Authenticate returns a middleware that verifies the token of every request
+// with the manager and stores its claims in the request context (see
+// token.ClaimsFromContext).
+//
+// Requests without token receive response_mapper.ErrTokenMissing() and
+// requests with an invalid or expired token the error of Manager.Verify,
+// rendered with RenderJSON as 401 Unauthorized.
+func Authenticate(manager *token.Manager, options ...OptionAuthenticate) func(http.Handler) http.Handler {
+	cfg := &authenticator{
+		extractor: BearerToken,
+	}
+	// Apply any passed options to the middleware.
+	for _, o := range options {
+		o(cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw := cfg.extractor(r)
+			if raw == "" {
+				if cfg.optional {
+					next.ServeHTTP(w, r)
+					return
+				}
+				unauthorized(w, r, response_mapper.ErrTokenMissing())
+				return
+			}
+
+			claims, err := manager.Verify(raw)
+			if err != nil {
+				unauthorized(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(token.ContextWithClaims(r.Context(), claims)))
+		})
+	}
+}
+
+// RequireScopes returns a middleware that only lets through requests whose
+// claims have all the scopes. It must run after Authenticate.
+//
+// Requests without claims receive response_mapper.ErrTokenMissing() and
+// requests missing a scope response_mapper.ErrCannotHaveAccessResources(),
+// rendered as 403 Forbidden.
+func RequireScopes(scopes ...string) func(http.Handler) http.Handler {
+	return RequireClaims(func(claims *token.Claims) bool {
+		for _, scope := range scopes {
+			if !claims.HasScope(scope) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// RequireClaims returns a middleware that only lets through requests whose
+// claims pass the check, e.g. a role in Claims.Extra. It must run after
+// Authenticate, and renders the same errors as RequireScopes.
+func RequireClaims(check func(claims *token.Claims) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims := token.ClaimsFromContext(r.Context())
+			if claims == nil {
+				unauthorized(w, r, response_mapper.ErrTokenMissing())
+				return
+			}
+			if !check(claims) {
+				response_mapper.RenderJSON(w, http.StatusForbidden, response_mapper.ErrCannotHaveAccessResources(), response_mapper.WithRequestContext(r.Context()))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// unauthorized renders a 401 Unauthorized error with the WWW-Authenticate challenge.
+func unauthorized(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	response_mapper.RenderJSON(w, http.StatusUnauthorized, err, response_mapper.WithRequestContext(r.Context()))
+}
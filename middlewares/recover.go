@@ -0,0 +1,91 @@
+// Package middlewares provides net/http middlewares that render their responses with response-mapper v1.
+package middlewares
+
+import (
+	"log"
+	"net/http"
+	"runtime/debug"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+)
+
+// PanicHook is called with the request, the recovered value and the stack trace of a panic.
+type PanicHook func(r *http.Request, recovered interface{}, stack []byte)
+
+// OptionRecover is a function type used for applying options to the Recover middleware.
+type OptionRecover func(*recoverer)
+
+// recoverer holds the configuration of the Recover middleware.
+type recoverer struct {
+	hook                PanicHook // hook reports the recovered panics.
+	repanicAbortHandler bool      // repanicAbortHandler re-panics http.ErrAbortHandler.
+}
+
+// WithPanicHook sets the hook used to report recovered panics.
+// By default panics are logged with log.Printf.
+func WithPanicHook(hook PanicHook) OptionRecover {
+	return func(r *recoverer) {
+		r.hook = hook
+	}
+}
+
+// WithRepanicAbortHandler sets whether http.ErrAbortHandler is re-panicked, so
+// net/http aborts the response as the handler intended. Defaults to true, false
+// swallows it and ends the response normally.
+func WithRepanicAbortHandler(repanic bool) OptionRecover {
+	return func(r *recoverer) {
+		r.repanicAbortHandler = repanic
+	}
+}
+
+// logPanic is the default PanicHook, it logs the panic and its stack trace.
+func logPanic(r *http.Request, recovered interface{}, stack []byte) {
+	log.Printf("%v %v panic recover: %v \n%s", r.Method, r.URL.Path, recovered, stack)
+}
+
+// Recover returns a middleware that recovers panics of the next handler.
+//
+// The panic and its stack trace are reported through the panic hook, and the
+// client receives response_mapper.ErrInternalServerError() rendered with
+// RenderJSON, unless the handler already started writing the response.
+// http.ErrAbortHandler is not reported and is re-panicked, see WithRepanicAbortHandler.
+func Recover(options ...OptionRecover) func(http.Handler) http.Handler {
+	cfg := &recoverer{
+		hook:                logPanic,
+		repanicAbortHandler: true,
+	}
+	// Apply any passed options to the middleware.
+	for _, o := range options {
+		o(cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ww, rw := wrapResponseWriter(w)
+
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+
+				if rec == http.ErrAbortHandler {
+					if cfg.repanicAbortHandler {
+						panic(rec)
+					}
+					return
+				}
+
+				if cfg.hook != nil {
+					cfg.hook(r, rec, debug.Stack())
+				}
+
+				if !rw.wroteHeader {
+					response_mapper.RenderJSON(rw, http.StatusInternalServerError, response_mapper.ErrInternalServerError(), response_mapper.WithRequestContext(r.Context()))
+				}
+			}()
+
+			next.ServeHTTP(ww, r)
+		})
+	}
+}
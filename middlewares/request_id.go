@@ -0,0 +1,98 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	help "github.com/adamnasrudin03/go-helpers"
+)
+
+// maxRequestIDLength is the longest incoming request ID that is reused.
+const maxRequestIDLength = 128
+
+// OptionRequestID is a function type used for applying options to the RequestID middleware.
+type OptionRequestID func(*requestIDConfig)
+
+// requestIDConfig holds the configuration of the RequestID middleware.
+type requestIDConfig struct {
+	header    string                 // header is the name of the request ID header.
+	generator func() (string, error) // generator creates new request IDs.
+}
+
+// WithRequestIDHeader sets the header used to read and echo the request ID.
+// Defaults to X-Request-ID. The header only applies to this middleware, the
+// request ID reaches the outbound HTTP helpers and response mapper through the
+// request context.
+func WithRequestIDHeader(header string) OptionRequestID {
+	return func(c *requestIDConfig) {
+		c.header = header
+	}
+}
+
+// WithRequestIDGenerator sets the function used to create new request IDs.
+// Defaults to a UUID v7 from help.GenerateUUID.
+func WithRequestIDGenerator(generator func() (string, error)) OptionRequestID {
+	return func(c *requestIDConfig) {
+		c.generator = generator
+	}
+}
+
+// generateRequestID is the default request ID generator.
+func generateRequestID() (string, error) {
+	id, err := help.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// RequestID returns a middleware that attaches a request ID to every request.
+//
+// It reuses the incoming X-Request-ID header when it is valid, otherwise it
+// creates a new one. The request ID is stored in the request context (see
+// help.RequestIDFromContext), echoed in the response header, forwarded by the
+// outbound HTTP helpers of help and rendered as request_id in ResponseError
+// by RenderJSON with WithRequestContext and by the framework adapters.
+func RequestID(options ...OptionRequestID) func(http.Handler) http.Handler {
+	cfg := &requestIDConfig{
+		header:    help.HeaderRequestID,
+		generator: generateRequestID,
+	}
+	// Apply any passed options to the middleware.
+	for _, o := range options {
+		o(cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := strings.TrimSpace(r.Header.Get(cfg.header))
+			if !isValidRequestID(requestID) {
+				var err error
+				if requestID, err = cfg.generator(); err != nil {
+					requestID = ""
+				}
+			}
+
+			if requestID != "" {
+				w.Header().Set(cfg.header, requestID)
+				r = r.WithContext(help.ContextWithRequestID(r.Context(), requestID))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isValidRequestID checks that an incoming request ID is short and only has printable ASCII characters,
+// so it is safe to echo and log.
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < '!' || requestID[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package middlewares
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+)
+
+// responseWriter wraps http.ResponseWriter to track whether the response has been started.
+type responseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+// wrapResponseWriter wraps w in a responseWriter. The returned writer also
+// implements http.Flusher, http.Hijacker and http.Pusher when w does, so type
+// assertions of the next handlers keep working.
+func wrapResponseWriter(w http.ResponseWriter) (http.ResponseWriter, *responseWriter) {
+	rw := &responseWriter{ResponseWriter: w}
+	_, isFlusher := w.(http.Flusher)
+	_, isHijacker := w.(http.Hijacker)
+	_, isPusher := w.(http.Pusher)
+
+	switch {
+	case isFlusher && isHijacker && isPusher:
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+		}{rw, flusher{rw}, hijacker{rw}, pusher{rw}}, rw
+	case isFlusher && isHijacker:
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+		}{rw, flusher{rw}, hijacker{rw}}, rw
+	case isFlusher && isPusher:
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+		}{rw, flusher{rw}, pusher{rw}}, rw
+	case isHijacker && isPusher:
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+		}{rw, hijacker{rw}, pusher{rw}}, rw
+	case isFlusher:
+		return struct {
+			*responseWriter
+			http.Flusher
+		}{rw, flusher{rw}}, rw
+	case isHijacker:
+		return struct {
+			*responseWriter
+			http.Hijacker
+		}{rw, hijacker{rw}}, rw
+	case isPusher:
+		return struct {
+			*responseWriter
+			http.Pusher
+		}{rw, pusher{rw}}, rw
+	}
+	return rw, rw
+}
+
+// WriteHeader writes the status code and marks the response as started.
+func (w *responseWriter) WriteHeader(statusCode int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write writes the body and marks the response as started.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying writer, so http.ResponseController can reach it.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// flusher implements http.Flusher for a responseWriter whose writer supports flushing.
+type flusher struct{ w *responseWriter }
+
+// Flush flushes the underlying writer and marks the response as started.
+func (f flusher) Flush() {
+	f.w.wroteHeader = true
+	f.w.ResponseWriter.(http.Flusher).Flush()
+}
+
+// hijacker implements http.Hijacker for a responseWriter whose writer supports hijacking.
+type hijacker struct{ w *responseWriter }
+
+// Hijack takes over the connection, after which no response can be written.
+func (h hijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, buf, err := h.w.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		h.w.wroteHeader = true
+	}
+	return conn, buf, err
+}
+
+// pusher implements http.Pusher for a responseWriter whose writer supports HTTP/2 server push.
+type pusher struct{ w *responseWriter }
+
+// Push initiates an HTTP/2 server push.
+func (p pusher) Push(target string, opts *http.PushOptions) error {
+	return p.w.ResponseWriter.(http.Pusher).Push(target, opts)
+}
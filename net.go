@@ -67,11 +67,20 @@ func StreamToByte(stream io.Reader) []byte {
 	return buf.Bytes()
 }
 
+// setRequestIDHeader sets the request ID header (see RequestIDHeader) of req from the request ID stored in ctx,
+// unless the header is already set.
+func setRequestIDHeader(ctx context.Context, req *http.Request) {
+	requestID := RequestIDFromContext(ctx)
+	if header := RequestIDHeader(); requestID != "" && req.Header.Get(header) == "" {
+		req.Header.Set(header, requestID)
+	}
+}
+
 // GetHTTPRequestJSON sends an HTTP request with the given method, URL, body, and timeout, and returns the response as a byte slice.
 // The function takes an optional set of headers to include with the request.
 //
 // Parameters:
-// - ctx: The context to use for the request.
+// - ctx: The context to use for the request, its request ID is forwarded in the X-Request-ID header.
 // - method: The HTTP method to use (e.g. "GET", "POST", etc.).
 // - url: The URL to send the request to.
 // - body: The body of the request to send.
@@ -83,7 +92,7 @@ func StreamToByte(stream io.Reader) []byte {
 // - statusCode: The HTTP status code of the response.
 // - err: An error if the request fails.
 func GetHTTPRequestJSON(ctx context.Context, method string, url string, body io.Reader, customTimeOut int, headers ...map[string]string) (res []byte, statusCode int, err error) {
-	defer PanicRecover("net-GetHTTPRequestJSON")
+	defer PanicRecoverError("net-GetHTTPRequestJSON", &err)
 
 	// Create an HTTP request with the given method, URL, and body.
 	req, err := http.NewRequest(method, url, body)
@@ -98,6 +107,9 @@ func GetHTTPRequestJSON(ctx context.Context, method string, url string, body io.
 		}
 	}
 
+	// Forward the request ID of the context, unless the headers already set one.
+	setRequestIDHeader(ctx, req)
+
 	// Create an HTTP client with the given timeout.
 	client := &http.Client{Timeout: time.Duration(customTimeOut) * time.Second}
 
@@ -134,7 +146,7 @@ func GetHTTPRequestJSON(ctx context.Context, method string, url string, body io.
 // Skips SSL certificate verification.
 //
 // Parameters:
-// - ctx: The context to use for the request.
+// - ctx: The context to use for the request, its request ID is forwarded in the X-Request-ID header.
 // - method: The HTTP method to use (e.g. "GET", "POST", etc.).
 // - url: The URL to send the request to.
 // - body: The body of the request to send.
@@ -146,7 +158,7 @@ func GetHTTPRequestJSON(ctx context.Context, method string, url string, body io.
 // - statusCode: The HTTP status code of the response.
 // - err: An error if the request fails.
 func GetHTTPRequestSkipVerify(ctx context.Context, method string, url string, body io.Reader, customTimeOut int, headers ...map[string]string) (res []byte, statusCode int, err error) {
-	defer PanicRecover("net-GetHTTPRequestSkipVerify")
+	defer PanicRecoverError("net-GetHTTPRequestSkipVerify", &err)
 
 	// Create an HTTP request with the given method, URL, and body.
 	req, err := http.NewRequest(method, url, body)
@@ -161,6 +173,9 @@ func GetHTTPRequestSkipVerify(ctx context.Context, method string, url string, bo
 		}
 	}
 
+	// Forward the request ID of the context, unless the headers already set one.
+	setRequestIDHeader(ctx, req)
+
 	// Create an HTTP client with the given timeout.
 	client := &http.Client{Timeout: time.Duration(customTimeOut) * time.Second}
 
@@ -0,0 +1,220 @@
+package otp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+)
+
+// Default parameters of the Manager.
+const (
+	DefaultCodeLength  = 6
+	DefaultTTL         = 5 * time.Minute
+	DefaultMaxAttempts = 5
+)
+
+// minSecretLength is the minimum length of the secret of a Manager, the size of the hash.
+const minSecretLength = 32
+
+// Errors returned by NewManager and the Store.
+var (
+	// ErrCodeNotFound is returned by a Store when no code is saved for a key.
+	ErrCodeNotFound = errors.New("otp: code not found")
+	// ErrWeakSecret is returned by NewManager for secrets shorter than 32 bytes.
+	ErrWeakSecret = errors.New("otp: secret must be at least 32 bytes")
+)
+
+// Code is a numeric OTP saved in a Store. Only the hash of the code is saved.
+type Code struct {
+	Hash      string    `json:"hash"`       // Hash is the hex HMAC-SHA256 of the code.
+	ExpiresAt time.Time `json:"expires_at"` // ExpiresAt is the time the code expires.
+	Attempts  int       `json:"attempts"`   // Attempts is the number of verifications.
+}
+
+// Store saves the codes of a Manager, e.g. in memory, Redis or a database.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Save saves the code of the key, replacing the previous one.
+	Save(ctx context.Context, key string, code Code) error
+	// Get returns the code of the key, or ErrCodeNotFound.
+	Get(ctx context.Context, key string) (Code, error)
+	// IncrementAttempts atomically increments the attempts of the code of the key and returns the new count,
+	// or ErrCodeNotFound.
+	IncrementAttempts(ctx context.Context, key string) (int, error)
+	// Consume atomically deletes the code of the key if its hash is the given hash, and reports
+	// whether it was deleted, so a code can only be consumed once by concurrent verifications.
+	Consume(ctx context.Context, key, hash string) (bool, error)
+	// Delete deletes the code of the key. Deleting a missing code is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// OptionManager is a function type used for applying options to the Manager.
+type OptionManager func(*Manager)
+
+// WithCodeLength sets the number of digits of the codes. Defaults to 6.
+func WithCodeLength(length int) OptionManager {
+	return func(m *Manager) {
+		if length > 0 {
+			m.length = length
+		}
+	}
+}
+
+// WithTTL sets how long the codes are valid. Defaults to 5 minutes.
+func WithTTL(ttl time.Duration) OptionManager {
+	return func(m *Manager) {
+		if ttl > 0 {
+			m.ttl = ttl
+		}
+	}
+}
+
+// WithMaxAttempts sets the number of failed verifications after which a code
+// is deleted. Defaults to 5.
+func WithMaxAttempts(attempts int) OptionManager {
+	return func(m *Manager) {
+		if attempts > 0 {
+			m.maxAttempts = attempts
+		}
+	}
+}
+
+// WithClock sets the function returning the current time. Defaults to time.Now.
+// Pass the same clock to a MemoryStore with WithMemoryStoreClock.
+func WithClock(now func() time.Time) OptionManager {
+	return func(m *Manager) {
+		m.now = now
+	}
+}
+
+// Manager generates short numeric codes, e.g. sent by email or SMS, and
+// verifies them with an expiry and a limited number of attempts.
+type Manager struct {
+	store       Store
+	secret      []byte
+	length      int
+	ttl         time.Duration
+	maxAttempts int
+	now         func() time.Time
+}
+
+// NewManager creates a Manager that saves its codes in the store.
+//
+// The codes are saved as an HMAC with the secret, which must be at least 32
+// bytes and kept out of the store: short numeric codes are easy to brute-force
+// from a plain hash. It returns ErrWeakSecret for shorter secrets.
+func NewManager(store Store, secret []byte, options ...OptionManager) (*Manager, error) {
+	if len(secret) < minSecretLength {
+		return nil, ErrWeakSecret
+	}
+
+	m := &Manager{
+		store:       store,
+		secret:      secret,
+		length:      DefaultCodeLength,
+		ttl:         DefaultTTL,
+		maxAttempts: DefaultMaxAttempts,
+		now:         time.Now,
+	}
+	// Apply any passed options to the manager.
+	for _, o := range options {
+		o(m)
+	}
+	return m, nil
+}
+
+// Generate creates a new code for the key, e.g. "login:<user id>", replacing
+// the previous one, and returns it to be sent to the user.
+// It returns response_mapper.ErrGenerateOtp on failure.
+func (m *Manager) Generate(ctx context.Context, key string) (string, error) {
+	code, err := help.RandomDigits(m.length)
+	if err != nil {
+		return "", response_mapper.ErrGenerateOtp().WithCause(err)
+	}
+
+	err = m.store.Save(ctx, key, Code{
+		Hash:      m.hashCode(key, code),
+		ExpiresAt: m.now().Add(m.ttl),
+	})
+	if err != nil {
+		return "", response_mapper.ErrGenerateOtp().WithCause(err)
+	}
+	return code, nil
+}
+
+// Verify checks the code of the key, comparing in constant time. A valid code
+// is consumed, so it can only be used once.
+//
+// Every verification counts as an attempt before the code is compared, so
+// concurrent guesses can not exceed the maximum number of attempts.
+//
+// It returns:
+//   - response_mapper.ErrOtpInvalid when no code exists, the code does not match or it was already used.
+//   - response_mapper.ErrOtpExpired when the code has expired.
+//   - response_mapper.ErrOtpTooManyAttempts when the maximum number of attempts is reached.
+//     The code is deleted and a new one must be generated.
+func (m *Manager) Verify(ctx context.Context, key, code string) error {
+	attempts, err := m.store.IncrementAttempts(ctx, key)
+	if errors.Is(err, ErrCodeNotFound) {
+		return response_mapper.ErrOtpInvalid()
+	}
+	if err != nil {
+		return response_mapper.ErrInternalServerError().WithCause(err)
+	}
+	if attempts > m.maxAttempts {
+		_ = m.store.Delete(ctx, key)
+		return response_mapper.ErrOtpTooManyAttempts()
+	}
+
+	saved, err := m.store.Get(ctx, key)
+	if errors.Is(err, ErrCodeNotFound) {
+		return response_mapper.ErrOtpInvalid()
+	}
+	if err != nil {
+		return response_mapper.ErrInternalServerError().WithCause(err)
+	}
+
+	if !m.now().Before(saved.ExpiresAt) {
+		_ = m.store.Delete(ctx, key)
+		return response_mapper.ErrOtpExpired()
+	}
+
+	hash := m.hashCode(key, code)
+	if subtle.ConstantTimeCompare([]byte(saved.Hash), []byte(hash)) == 1 {
+		consumed, err := m.store.Consume(ctx, key, hash)
+		if err != nil {
+			return response_mapper.ErrInternalServerError().WithCause(err)
+		}
+		if !consumed {
+			return response_mapper.ErrOtpInvalid()
+		}
+		return nil
+	}
+
+	if attempts >= m.maxAttempts {
+		_ = m.store.Delete(ctx, key)
+		return response_mapper.ErrOtpTooManyAttempts()
+	}
+	return response_mapper.ErrOtpInvalid()
+}
+
+// Revoke deletes the code of the key.
+func (m *Manager) Revoke(ctx context.Context, key string) error {
+	return m.store.Delete(ctx, key)
+}
+
+// hashCode returns the hex HMAC-SHA256 of the code bound to its key, keyed
+// with the secret of the Manager, so a code can not be replayed for another
+// key and a leaked store can not be brute-forced without the secret.
+func (m *Manager) hashCode(key, code string) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(key + "\x00" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
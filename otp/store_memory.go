@@ -0,0 +1,104 @@
+package otp
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// OptionMemoryStore is a function type used for applying options to a MemoryStore.
+type OptionMemoryStore func(*MemoryStore)
+
+// WithMemoryStoreClock sets the function returning the current time, used to
+// remove expired codes. Defaults to time.Now, use the clock of WithClock.
+func WithMemoryStoreClock(now func() time.Time) OptionMemoryStore {
+	return func(s *MemoryStore) {
+		s.now = now
+	}
+}
+
+// MemoryStore is an in-memory Store, for tests and single-instance applications.
+// Expired codes are removed when another code is saved.
+type MemoryStore struct {
+	mu    sync.Mutex
+	codes map[string]Code
+	now   func() time.Time
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore(options ...OptionMemoryStore) *MemoryStore {
+	s := &MemoryStore{
+		codes: make(map[string]Code),
+		now:   time.Now,
+	}
+	// Apply any passed options to the store.
+	for _, o := range options {
+		o(s)
+	}
+	return s
+}
+
+// Save saves the code of the key, replacing the previous one.
+func (s *MemoryStore) Save(_ context.Context, key string, code Code) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Remove the expired codes, so abandoned keys do not grow the map forever.
+	now := s.now()
+	for k, c := range s.codes {
+		if !now.Before(c.ExpiresAt) {
+			delete(s.codes, k)
+		}
+	}
+
+	s.codes[key] = code
+	return nil
+}
+
+// Get returns the code of the key, or ErrCodeNotFound.
+func (s *MemoryStore) Get(_ context.Context, key string) (Code, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code, ok := s.codes[key]
+	if !ok {
+		return Code{}, ErrCodeNotFound
+	}
+	return code, nil
+}
+
+// IncrementAttempts increments the attempts of the code of the key and returns the new count.
+func (s *MemoryStore) IncrementAttempts(_ context.Context, key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code, ok := s.codes[key]
+	if !ok {
+		return 0, ErrCodeNotFound
+	}
+	code.Attempts++
+	s.codes[key] = code
+	return code.Attempts, nil
+}
+
+// Consume deletes the code of the key if its hash is the given hash, and reports whether it was deleted.
+func (s *MemoryStore) Consume(_ context.Context, key, hash string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code, ok := s.codes[key]
+	if !ok || code.Hash != hash {
+		return false, nil
+	}
+	delete(s.codes, key)
+	return true, nil
+}
+
+// Delete deletes the code of the key.
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.codes, key)
+	return nil
+}
@@ -0,0 +1,257 @@
+// Package otp generates and verifies one-time passwords: RFC 4226 HOTP and
+// RFC 6238 TOTP codes for authenticator apps, and short numeric codes sent by
+// email or SMS, see Manager.
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Algorithm is the HMAC hash function of HOTP and TOTP codes.
+type Algorithm string
+
+// The algorithms of HOTP and TOTP codes. Most authenticator apps only support SHA1.
+const (
+	AlgorithmSHA1   Algorithm = "SHA1"
+	AlgorithmSHA256 Algorithm = "SHA256"
+	AlgorithmSHA512 Algorithm = "SHA512"
+)
+
+// Default parameters of HOTP and TOTP codes.
+const (
+	DefaultDigits     = 6
+	DefaultPeriod     = 30 * time.Second
+	DefaultSkew       = 1
+	DefaultSecretSize = 20
+)
+
+// ErrInvalidSecret is returned when a secret is not valid base32.
+var ErrInvalidSecret = errors.New("otp: invalid base32 secret")
+
+// secretEncoding is the base32 encoding of secrets, without padding as in otpauth URIs.
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Option is a function type used for applying options to HOTP and TOTP codes.
+type Option func(*config)
+
+// config holds the parameters of HOTP and TOTP codes.
+type config struct {
+	digits    int           // digits is the number of digits of a code, 6 or 8.
+	algorithm Algorithm     // algorithm is the HMAC hash function.
+	period    time.Duration // period is the time step of TOTP codes.
+	skew      int           // skew is the number of periods accepted before and after the current one.
+}
+
+// WithDigits sets the number of digits of the codes, from 6 to 8. Defaults to 6.
+func WithDigits(digits int) Option {
+	return func(c *config) {
+		if digits >= 6 && digits <= 8 {
+			c.digits = digits
+		}
+	}
+}
+
+// WithAlgorithm sets the HMAC hash function. Defaults to AlgorithmSHA1, which
+// is kept for other algorithms than AlgorithmSHA1, AlgorithmSHA256 and AlgorithmSHA512.
+func WithAlgorithm(algorithm Algorithm) Option {
+	return func(c *config) {
+		switch algorithm {
+		case AlgorithmSHA1, AlgorithmSHA256, AlgorithmSHA512:
+			c.algorithm = algorithm
+		}
+	}
+}
+
+// WithPeriod sets the time step of TOTP codes. Defaults to 30 seconds.
+func WithPeriod(period time.Duration) Option {
+	return func(c *config) {
+		if period >= time.Second {
+			c.period = period
+		}
+	}
+}
+
+// WithSkew sets the number of periods accepted before and after the current
+// one by VerifyTOTP, to tolerate clock drift. Defaults to 1.
+func WithSkew(skew int) Option {
+	return func(c *config) {
+		if skew >= 0 {
+			c.skew = skew
+		}
+	}
+}
+
+// newConfig creates a config with the given options.
+func newConfig(options ...Option) *config {
+	cfg := &config{
+		digits:    DefaultDigits,
+		algorithm: AlgorithmSHA1,
+		period:    DefaultPeriod,
+		skew:      DefaultSkew,
+	}
+	// Apply any passed options to the codes.
+	for _, o := range options {
+		o(cfg)
+	}
+	return cfg
+}
+
+// hash returns the hash function of the algorithm.
+func (c *config) hash() func() hash.Hash {
+	switch c.algorithm {
+	case AlgorithmSHA256:
+		return sha256.New
+	case AlgorithmSHA512:
+		return sha512.New
+	default:
+		return sha1.New
+	}
+}
+
+// GenerateSecret returns a random base32 secret of size bytes for HOTP and
+// TOTP, 20 bytes (160 bits) when size is zero or less.
+func GenerateSecret(size int) (string, error) {
+	if size <= 0 {
+		size = DefaultSecretSize
+	}
+
+	secret := make([]byte, size)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return secretEncoding.EncodeToString(secret), nil
+}
+
+// decodeSecret decodes a base32 secret, ignoring spaces, case and padding.
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := secretEncoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return key, nil
+}
+
+// HOTP returns the RFC 4226 code of the counter.
+//
+// Parameters:
+// - secret: The base32 secret.
+// - counter: The moving factor.
+// - options: WithDigits and WithAlgorithm.
+func HOTP(secret string, counter uint64, options ...Option) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, counter, newConfig(options...)), nil
+}
+
+// hotp computes the code of the counter with dynamic truncation.
+func hotp(key []byte, counter uint64, cfg *config) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(cfg.hash(), key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < cfg.digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", cfg.digits, value%mod)
+}
+
+// VerifyHOTP reports whether the code is the RFC 4226 code of the counter, in constant time.
+func VerifyHOTP(secret, code string, counter uint64, options ...Option) (bool, error) {
+	expected, err := HOTP(secret, counter, options...)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1, nil
+}
+
+// TOTP returns the RFC 6238 code at the given time.
+//
+// Parameters:
+// - secret: The base32 secret.
+// - t: The time of the code, usually time.Now().
+// - options: WithDigits, WithAlgorithm and WithPeriod.
+func TOTP(secret string, t time.Time, options ...Option) (string, error) {
+	cfg := newConfig(options...)
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, timeCounter(t, cfg.period), cfg), nil
+}
+
+// VerifyTOTP reports whether the code is the RFC 6238 code at the given time,
+// or of the periods around it allowed by WithSkew, comparing in constant time.
+func VerifyTOTP(secret, code string, t time.Time, options ...Option) (bool, error) {
+	cfg := newConfig(options...)
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return false, err
+	}
+
+	counter := timeCounter(t, cfg.period)
+	valid := 0
+	for i := -cfg.skew; i <= cfg.skew; i++ {
+		if i < 0 && counter < uint64(-i) {
+			continue
+		}
+		expected := hotp(key, counter+uint64(i), cfg)
+		// Check every period, so the duration does not tell which one matched.
+		valid |= subtle.ConstantTimeCompare([]byte(expected), []byte(code))
+	}
+	return valid == 1, nil
+}
+
+// timeCounter returns the number of periods since the Unix epoch.
+func timeCounter(t time.Time, period time.Duration) uint64 {
+	return uint64(t.Unix()) / uint64(period/time.Second)
+}
+
+// KeyURI returns the otpauth:// URI of a TOTP secret, shown as a QR code for authenticator apps.
+//
+// Parameters:
+// - issuer: The name of the application, e.g. "My App".
+// - account: The account of the user, e.g. the email.
+// - secret: The base32 secret.
+// - options: WithDigits, WithAlgorithm and WithPeriod.
+func KeyURI(issuer, account, secret string, options ...Option) string {
+	cfg := newConfig(options...)
+
+	query := url.Values{}
+	query.Set("secret", strings.TrimRight(strings.ToUpper(secret), "="))
+	query.Set("issuer", issuer)
+	query.Set("algorithm", string(cfg.algorithm))
+	query.Set("digits", strconv.Itoa(cfg.digits))
+	query.Set("period", strconv.Itoa(int(cfg.period/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
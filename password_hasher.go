@@ -0,0 +1,355 @@
+package help
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Errors returned by the password hashers.
+var (
+	// ErrUnknownPasswordHash is returned when a hash was not created by a supported algorithm.
+	ErrUnknownPasswordHash = errors.New("password hash: unknown algorithm")
+	// ErrInvalidPasswordHash is returned when a hash of a supported algorithm is malformed.
+	ErrInvalidPasswordHash = errors.New("password hash: invalid format")
+	// ErrInvalidHasherParams is returned when a hasher has zero or out of range
+	// parameters, e.g. an Argon2idHasher literal instead of NewArgon2idHasher.
+	ErrInvalidHasherParams = errors.New("password hash: invalid hasher parameters")
+	// ErrPasswordTooLong is returned by BcryptHasher for passwords over 72 bytes,
+	// which bcrypt would otherwise truncate.
+	ErrPasswordTooLong = bcrypt.ErrPasswordTooLong
+)
+
+// bcryptMaxPasswordLength is the number of bytes bcrypt uses from a password.
+const bcryptMaxPasswordLength = 72
+
+// Bounds of the parameters read from stored hashes, so a malformed or
+// malicious hash can not crash the key derivation or exhaust the memory.
+const (
+	maxArgon2Memory     = 1 << 20 // maxArgon2Memory is 1 GiB, in KiB.
+	maxArgon2Iterations = 64
+	maxScryptLogN       = 20
+	maxScryptR          = 32
+	maxScryptP          = 16
+	maxScryptMemory     = 1 << 30 // maxScryptMemory is 1 GiB, the 128*r*N bytes used by scrypt.
+	maxPHCKeyLength     = 128
+)
+
+// PasswordHasher hashes passwords into self-describing strings, such as PHC strings.
+type PasswordHasher interface {
+	// Hash returns the hash of the password, with its algorithm, parameters and salt.
+	Hash(password string) (string, error)
+	// Verify reports whether the password matches the hash. The hash must be of the algorithm of the hasher.
+	Verify(hash, password string) (bool, error)
+	// NeedsRehash reports whether the hash was created by another algorithm or with other parameters.
+	NeedsRehash(hash string) bool
+}
+
+// DefaultPasswordHasher is the hasher used by HashPassword and the hasher whose
+// parameters PasswordIsValid compares stored hashes with.
+var DefaultPasswordHasher PasswordHasher = NewArgon2idHasher()
+
+// phcEncoding is the base64 encoding of the salt and hash of PHC strings.
+var phcEncoding = base64.RawStdEncoding
+
+// Argon2idHasher hashes passwords with argon2id into PHC strings:
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+type Argon2idHasher struct {
+	Memory      uint32 // Memory is the memory cost in KiB.
+	Iterations  uint32 // Iterations is the time cost.
+	Parallelism uint8  // Parallelism is the number of threads.
+	SaltLength  uint32 // SaltLength is the length of the random salt in bytes.
+	KeyLength   uint32 // KeyLength is the length of the hash in bytes.
+}
+
+// NewArgon2idHasher creates an Argon2idHasher with the parameters recommended
+// by OWASP: 19 MiB of memory, 2 iterations and 1 thread.
+func NewArgon2idHasher() *Argon2idHasher {
+	return &Argon2idHasher{
+		Memory:      19 * 1024,
+		Iterations:  2,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// Hash returns the argon2id PHC string of the password, or ErrInvalidHasherParams
+// when a parameter is zero or the memory is less than 8 KiB per thread.
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	if h.Iterations < 1 || h.Parallelism < 1 || h.Memory < 8*uint32(h.Parallelism) || h.SaltLength < 1 || h.KeyLength < 1 {
+		return "", ErrInvalidHasherParams
+	}
+
+	salt, err := randomSalt(int(h.SaltLength))
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.Iterations, h.Memory, h.Parallelism, h.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.Memory, h.Iterations, h.Parallelism,
+		phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+}
+
+// Verify reports whether the password matches the argon2id hash, with the parameters stored in the hash.
+func (h *Argon2idHasher) Verify(hash, password string) (bool, error) {
+	params, salt, key, err := parseArgon2id(hash)
+	if err != nil {
+		return false, err
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+// NeedsRehash reports whether the hash is not an argon2id hash with the parameters of the hasher.
+func (h *Argon2idHasher) NeedsRehash(hash string) bool {
+	params, salt, key, err := parseArgon2id(hash)
+	if err != nil {
+		return true
+	}
+	return params.Memory != h.Memory || params.Iterations != h.Iterations || params.Parallelism != h.Parallelism ||
+		uint32(len(salt)) != h.SaltLength || uint32(len(key)) != h.KeyLength
+}
+
+// parseArgon2id parses an argon2id PHC string.
+func parseArgon2id(hash string) (params Argon2idHasher, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	if parts[2] != "v="+strconv.Itoa(argon2.Version) {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+
+	values, err := parsePHCParams(parts[3], "m", "t", "p")
+	if err != nil || values["p"] < 1 || values["p"] > 255 || values["t"] < 1 || values["t"] > maxArgon2Iterations ||
+		values["m"] < 8*values["p"] || values["m"] > maxArgon2Memory {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	params.Memory = uint32(values["m"])
+	params.Iterations = uint32(values["t"])
+	params.Parallelism = uint8(values["p"])
+
+	salt, key, err = decodeSaltAndKey(parts[4], parts[5])
+	return params, salt, key, err
+}
+
+// ScryptHasher hashes passwords with scrypt into PHC strings, with the cost as log2(N):
+//
+//	$scrypt$ln=15,r=8,p=1$<salt>$<hash>
+type ScryptHasher struct {
+	LogN       uint8 // LogN is log2 of the CPU/memory cost N.
+	R          int   // R is the block size.
+	P          int   // P is the parallelization.
+	SaltLength int   // SaltLength is the length of the random salt in bytes.
+	KeyLength  int   // KeyLength is the length of the hash in bytes.
+}
+
+// NewScryptHasher creates a ScryptHasher with the parameters recommended by OWASP: N=2^17, r=8 and p=1.
+func NewScryptHasher() *ScryptHasher {
+	return &ScryptHasher{
+		LogN:       17,
+		R:          8,
+		P:          1,
+		SaltLength: 16,
+		KeyLength:  32,
+	}
+}
+
+// Hash returns the scrypt PHC string of the password, or ErrInvalidHasherParams
+// when a parameter is zero.
+func (h *ScryptHasher) Hash(password string) (string, error) {
+	if h.LogN < 1 || h.R < 1 || h.P < 1 || h.SaltLength < 1 || h.KeyLength < 1 {
+		return "", ErrInvalidHasherParams
+	}
+
+	salt, err := randomSalt(h.SaltLength)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, 1<<h.LogN, h.R, h.P, h.KeyLength)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s", h.LogN, h.R, h.P,
+		phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+}
+
+// Verify reports whether the password matches the scrypt hash, with the parameters stored in the hash.
+func (h *ScryptHasher) Verify(hash, password string) (bool, error) {
+	params, salt, key, err := parseScrypt(hash)
+	if err != nil {
+		return false, err
+	}
+
+	other, err := scrypt.Key([]byte(password), salt, 1<<params.LogN, params.R, params.P, len(key))
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+// NeedsRehash reports whether the hash is not a scrypt hash with the parameters of the hasher.
+func (h *ScryptHasher) NeedsRehash(hash string) bool {
+	params, salt, key, err := parseScrypt(hash)
+	if err != nil {
+		return true
+	}
+	return params.LogN != h.LogN || params.R != h.R || params.P != h.P ||
+		len(salt) != h.SaltLength || len(key) != h.KeyLength
+}
+
+// parseScrypt parses a scrypt PHC string.
+func parseScrypt(hash string) (params ScryptHasher, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 5 || parts[0] != "" || parts[1] != "scrypt" {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+
+	values, err := parsePHCParams(parts[2], "ln", "r", "p")
+	if err != nil || values["ln"] < 1 || values["ln"] > maxScryptLogN || values["r"] < 1 || values["r"] > maxScryptR ||
+		values["p"] < 1 || values["p"] > maxScryptP || 128*values["r"]<<values["ln"] > maxScryptMemory {
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+	params.LogN = uint8(values["ln"])
+	params.R = int(values["r"])
+	params.P = int(values["p"])
+
+	salt, key, err = decodeSaltAndKey(parts[3], parts[4])
+	return params, salt, key, err
+}
+
+// BcryptHasher hashes passwords with bcrypt, in its own $2a$ format.
+//
+// bcrypt only uses the first 72 bytes of a password, so longer passwords are
+// rejected with ErrPasswordTooLong instead of being truncated.
+type BcryptHasher struct {
+	Cost int // Cost is the bcrypt cost, between bcrypt.MinCost and bcrypt.MaxCost.
+}
+
+// NewBcryptHasher creates a BcryptHasher with a cost of 12.
+func NewBcryptHasher() *BcryptHasher {
+	return &BcryptHasher{Cost: 12}
+}
+
+// Hash returns the bcrypt hash of the password.
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	if len(password) > bcryptMaxPasswordLength {
+		return "", ErrPasswordTooLong
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Verify reports whether the password matches the bcrypt hash.
+func (h *BcryptHasher) Verify(hash, password string) (bool, error) {
+	if len(password) > bcryptMaxPasswordLength {
+		return false, ErrPasswordTooLong
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+		return false, nil
+	}
+	return false, err
+}
+
+// NeedsRehash reports whether the hash is not a bcrypt hash with the cost of the hasher.
+func (h *BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.Cost
+}
+
+// isBcryptHash reports whether the hash is in the bcrypt format.
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// hasherFor returns a hasher able to verify the hash, detected from its prefix.
+func hasherFor(hash string) (PasswordHasher, error) {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return NewArgon2idHasher(), nil
+	case strings.HasPrefix(hash, "$scrypt$"):
+		return NewScryptHasher(), nil
+	case isBcryptHash(hash):
+		return NewBcryptHasher(), nil
+	}
+	return nil, ErrUnknownPasswordHash
+}
+
+// VerifyPassword reports whether the password matches the hash, detecting the
+// algorithm of the hash (argon2id, scrypt or bcrypt).
+func VerifyPassword(hash, password string) (bool, error) {
+	hasher, err := hasherFor(hash)
+	if err != nil {
+		return false, err
+	}
+	return hasher.Verify(hash, password)
+}
+
+// randomSalt returns n random bytes.
+func randomSalt(n int) ([]byte, error) {
+	salt := make([]byte, n)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// parsePHCParams parses the "k=v,k=v" parameters of a PHC string, which must have exactly the given keys.
+func parsePHCParams(s string, keys ...string) (map[string]uint64, error) {
+	values := make(map[string]uint64, len(keys))
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, ErrInvalidPasswordHash
+		}
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, ErrInvalidPasswordHash
+		}
+		values[k] = n
+	}
+
+	for _, k := range keys {
+		if _, ok := values[k]; !ok {
+			return nil, ErrInvalidPasswordHash
+		}
+	}
+	if len(values) != len(keys) {
+		return nil, ErrInvalidPasswordHash
+	}
+	return values, nil
+}
+
+// decodeSaltAndKey decodes the base64 salt and hash of a PHC string.
+func decodeSaltAndKey(encodedSalt, encodedKey string) (salt, key []byte, err error) {
+	salt, err = phcEncoding.DecodeString(encodedSalt)
+	if err != nil {
+		return nil, nil, ErrInvalidPasswordHash
+	}
+	key, err = phcEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) == 0 || len(key) > maxPHCKeyLength {
+		return nil, nil, ErrInvalidPasswordHash
+	}
+	return salt, key, nil
+}
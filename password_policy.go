@@ -0,0 +1,325 @@
+package help
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// commonPasswordsFile holds the common passwords rejected by PasswordPolicy.DisallowCommon.
+//
+//go:embed password_common.txt
+var commonPasswordsFile []byte
+
+// commonPasswords is the set of common passwords, in lowercase.
+var commonPasswords = parseCommonPasswords(commonPasswordsFile)
+
+// parseCommonPasswords parses a list of passwords, one per line, skipping empty lines and # comments.
+func parseCommonPasswords(data []byte) map[string]bool {
+	passwords := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			passwords[strings.ToLower(line)] = true
+		}
+	}
+	return passwords
+}
+
+// IsCommonPassword checks if the given password is in the embedded list of common passwords, ignoring case.
+func IsCommonPassword(password string) bool {
+	return commonPasswords[strings.ToLower(strings.TrimSpace(password))]
+}
+
+// PasswordRule identifies a rule of a PasswordPolicy.
+type PasswordRule string
+
+// The rules of a PasswordPolicy.
+const (
+	PasswordRuleMinLength   PasswordRule = "min_length"
+	PasswordRuleMaxLength   PasswordRule = "max_length"
+	PasswordRuleUpper       PasswordRule = "upper"
+	PasswordRuleLower       PasswordRule = "lower"
+	PasswordRuleDigit       PasswordRule = "digit"
+	PasswordRuleSymbol      PasswordRule = "symbol"
+	PasswordRuleCommon      PasswordRule = "common"
+	PasswordRuleUserInfo    PasswordRule = "user_info"
+	PasswordRuleRepeated    PasswordRule = "repeated"
+	PasswordRuleMinStrength PasswordRule = "min_strength"
+)
+
+// passwordRuleMessages holds the Indonesian and English messages of each rule,
+// formatted with the parameter of the violation.
+var passwordRuleMessages = map[PasswordRule][2]string{
+	PasswordRuleMinLength:   {"minimal %d karakter", "must be at least %d characters long"},
+	PasswordRuleMaxLength:   {"maksimal %d byte", "must be at most %d bytes long"},
+	PasswordRuleUpper:       {"harus mengandung huruf besar", "must contain an uppercase letter"},
+	PasswordRuleLower:       {"harus mengandung huruf kecil", "must contain a lowercase letter"},
+	PasswordRuleDigit:       {"harus mengandung angka", "must contain a digit"},
+	PasswordRuleSymbol:      {"harus mengandung simbol", "must contain a symbol"},
+	PasswordRuleCommon:      {"terlalu umum digunakan", "is too common"},
+	PasswordRuleUserInfo:    {"tidak boleh mengandung nama pengguna atau email", "must not contain the username or email"},
+	PasswordRuleRepeated:    {"tidak boleh mengulang karakter lebih dari %d kali berturut-turut", "must not repeat a character more than %d times in a row"},
+	PasswordRuleMinStrength: {"terlalu lemah", "is too weak"},
+}
+
+// PasswordViolation is a rule of a PasswordPolicy that a password does not satisfy.
+type PasswordViolation struct {
+	Rule  PasswordRule `json:"rule"`
+	Param int          `json:"param,omitempty"` // Param is the limit of the rule, e.g. the minimum length.
+}
+
+// Message returns the message of the violation in the given language, LangID
+// or LangEn, e.g. "must be at least 8 characters long". Other languages get
+// the English message.
+func (v PasswordViolation) Message(lang string) string {
+	messages, ok := passwordRuleMessages[v.Rule]
+	if !ok {
+		return string(v.Rule)
+	}
+
+	msg := messages[1]
+	if lang == LangID {
+		msg = messages[0]
+	}
+	if strings.Contains(msg, "%d") {
+		return fmt.Sprintf(msg, v.Param)
+	}
+	return msg
+}
+
+// PasswordPolicy describes the rules a password must satisfy.
+// Zero values disable the rules, see DefaultPasswordPolicy for a sensible policy.
+type PasswordPolicy struct {
+	MinLength      int  // MinLength is the minimum number of characters.
+	MaxLength      int  // MaxLength is the maximum number of bytes, as bcrypt limits passwords to 72 bytes.
+	RequireUpper   bool // RequireUpper requires an uppercase letter.
+	RequireLower   bool // RequireLower requires a lowercase letter.
+	RequireDigit   bool // RequireDigit requires a digit.
+	RequireSymbol  bool // RequireSymbol requires a character that is not a letter or a digit.
+	DisallowCommon bool // DisallowCommon rejects the passwords of the embedded common password list.
+	MaxRepeated    int  // MaxRepeated is the maximum number of identical characters in a row.
+	MinStrength    int  // MinStrength is the minimum PasswordStrength score, from 0 to 4.
+}
+
+// DefaultPasswordPolicy returns a policy of at least 8 characters and at most
+// 72 bytes (the bcrypt limit), with an uppercase and a lowercase letter, a digit and a symbol, no
+// common passwords and no character repeated more than 3 times in a row.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:      8,
+		MaxLength:      72,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSymbol:  true,
+		DisallowCommon: true,
+		MaxRepeated:    3,
+	}
+}
+
+// Check returns the rules of the policy the password does not satisfy, or nil when it satisfies all of them.
+//
+// Parameters:
+// - password: The password to check.
+// - userInputs: Values the password must not contain, ignoring case, such as
+// the username or the email. The local part of an email is checked as well.
+// Values shorter than 3 characters are ignored.
+func (p PasswordPolicy) Check(password string, userInputs ...string) []PasswordViolation {
+	var violations []PasswordViolation
+	add := func(rule PasswordRule, param int) {
+		violations = append(violations, PasswordViolation{Rule: rule, Param: param})
+	}
+
+	if p.MinLength > 0 && utf8.RuneCountInString(password) < p.MinLength {
+		add(PasswordRuleMinLength, p.MinLength)
+	}
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		add(PasswordRuleMaxLength, p.MaxLength)
+	}
+
+	classes := passwordClasses(password)
+	if p.RequireUpper && !classes.upper {
+		add(PasswordRuleUpper, 0)
+	}
+	if p.RequireLower && !classes.lower {
+		add(PasswordRuleLower, 0)
+	}
+	if p.RequireDigit && !classes.digit {
+		add(PasswordRuleDigit, 0)
+	}
+	if p.RequireSymbol && !classes.symbol {
+		add(PasswordRuleSymbol, 0)
+	}
+
+	if p.DisallowCommon && IsCommonPassword(password) {
+		add(PasswordRuleCommon, 0)
+	}
+	if containsUserInput(password, userInputs) {
+		add(PasswordRuleUserInfo, 0)
+	}
+	if p.MaxRepeated > 0 && maxRepeated(password) > p.MaxRepeated {
+		add(PasswordRuleRepeated, p.MaxRepeated)
+	}
+	if p.MinStrength > 0 && PasswordStrength(password).Score < p.MinStrength {
+		add(PasswordRuleMinStrength, p.MinStrength)
+	}
+
+	return violations
+}
+
+// passwordCharClasses holds the character classes found in a password.
+type passwordCharClasses struct {
+	upper, lower, digit, symbol, other bool
+}
+
+// passwordClasses returns the character classes of a password. Letters
+// without case, such as CJK, count as other characters.
+func passwordClasses(password string) passwordCharClasses {
+	var c passwordCharClasses
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			c.upper = true
+		case unicode.IsLower(r):
+			c.lower = true
+		case unicode.IsDigit(r):
+			c.digit = true
+		case r < utf8.RuneSelf:
+			c.symbol = true
+		default:
+			if unicode.IsLetter(r) {
+				c.other = true
+			} else {
+				c.symbol = true
+			}
+		}
+	}
+	return c
+}
+
+// containsUserInput reports whether the password contains one of the user inputs, ignoring case.
+func containsUserInput(password string, userInputs []string) bool {
+	password = strings.ToLower(password)
+	for _, input := range userInputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		candidates := []string{input}
+		if local, _, ok := strings.Cut(input, "@"); ok {
+			candidates = append(candidates, local)
+		}
+
+		for _, c := range candidates {
+			if utf8.RuneCountInString(c) >= 3 && strings.Contains(password, c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// maxRepeated returns the longest run of identical characters in a password.
+func maxRepeated(password string) int {
+	var (
+		longest, run int
+		prev         rune = -1
+	)
+	for _, r := range password {
+		if r == prev {
+			run++
+		} else {
+			run = 1
+			prev = r
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// PasswordScore is the estimated strength of a password.
+type PasswordScore struct {
+	Entropy float64 `json:"entropy"` // Entropy is the estimated entropy in bits.
+	Score   int     `json:"score"`   // Score goes from 0 (very weak) to 4 (very strong).
+}
+
+// passwordScoreLabels holds the Indonesian and English labels of each score.
+var passwordScoreLabels = [5][2]string{
+	{"Sangat lemah", "Very weak"},
+	{"Lemah", "Weak"},
+	{"Cukup", "Fair"},
+	{"Kuat", "Strong"},
+	{"Sangat kuat", "Very strong"},
+}
+
+// Label returns the label of the score in the given language, LangID or LangEn, e.g. "Strong".
+func (s PasswordScore) Label(lang string) string {
+	labels := passwordScoreLabels[max(0, min(s.Score, 4))]
+	if lang == LangID {
+		return labels[0]
+	}
+	return labels[1]
+}
+
+// PasswordStrength estimates the strength of a password from its entropy.
+//
+// The entropy is the number of characters times log2 of the size of the
+// character pool of the classes used (26 lowercase, 26 uppercase, 10 digits,
+// 33 symbols, 100 other letters). Repeated characters only count once per run,
+// and common passwords have no entropy. The score is 0 below 28 bits, 1 below
+// 36, 2 below 60, 3 below 80 and 4 above.
+func PasswordStrength(password string) PasswordScore {
+	if password == "" || IsCommonPassword(password) {
+		return PasswordScore{}
+	}
+
+	classes := passwordClasses(password)
+	pool := 0
+	for _, c := range []struct {
+		used bool
+		size int
+	}{
+		{classes.lower, 26},
+		{classes.upper, 26},
+		{classes.digit, 10},
+		{classes.symbol, 33},
+		{classes.other, 100},
+	} {
+		if c.used {
+			pool += c.size
+		}
+	}
+
+	// Count the runs of identical characters, so "aaaaaaaa" is as weak as "a".
+	length := 0
+	prev := rune(-1)
+	for _, r := range password {
+		if r != prev {
+			length++
+			prev = r
+		}
+	}
+
+	entropy := math.Round(float64(length)*math.Log2(float64(pool))*100) / 100
+
+	var score int
+	switch {
+	case entropy < 28:
+		score = 0
+	case entropy < 36:
+		score = 1
+	case entropy < 60:
+		score = 2
+	case entropy < 80:
+		score = 3
+	default:
+		score = 4
+	}
+	return PasswordScore{Entropy: entropy, Score: score}
+}
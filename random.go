@@ -0,0 +1,191 @@
+package help
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// Character classes of the random generators.
+const (
+	CharsetLower        = "abcdefghijklmnopqrstuvwxyz"
+	CharsetUpper        = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	CharsetDigits       = "0123456789"
+	CharsetSymbols      = "!@#$%^&*()+,-.:;<=>?[]_{}"
+	CharsetAlphanumeric = CharsetAlphabet + CharsetDigits
+)
+
+// maxRandomNumberLength is the longest length of RandomNumber that fits in an int64.
+const maxRandomNumberLength = 18
+
+// Errors returned by the random generators.
+var (
+	// ErrInvalidRandomLength is returned for a negative length, or a length too short for the required character classes.
+	ErrInvalidRandomLength = errors.New("random: invalid length")
+	// ErrEmptyCharset is returned when a charset has no characters.
+	ErrEmptyCharset = errors.New("random: empty charset")
+)
+
+// RandomInt returns a uniform random number in [0, max) from crypto/rand.
+//
+// Parameters:
+// - max: The exclusive upper bound, greater than zero.
+//
+// Returns:
+// - The random number, and an error if max is not positive or the system has no entropy.
+func RandomInt(max int64) (int64, error) {
+	if max <= 0 {
+		return 0, ErrInvalidRandomLength
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(max))
+	if err != nil {
+		return 0, err
+	}
+	return n.Int64(), nil
+}
+
+// RandomString returns a random string of the given number of characters, each
+// picked uniformly from charset with crypto/rand. It is safe for passwords, OTPs and tokens.
+//
+// Parameters:
+// - length: The number of characters.
+// - charset: The characters to pick from, e.g. CharsetAlphanumeric. It may contain multi-byte characters.
+//
+// Returns:
+// - The random string, and an error if the charset is empty or the system has no entropy.
+func RandomString(length int, charset string) (string, error) {
+	if length < 0 {
+		return "", ErrInvalidRandomLength
+	}
+	chars := []rune(charset)
+	if len(chars) == 0 {
+		return "", ErrEmptyCharset
+	}
+
+	out := make([]rune, length)
+	if err := fillRandom(out, chars); err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// RandomDigits returns a random string of the given number of digits, keeping
+// leading zeros, e.g. "004271" for a 6-digit OTP.
+func RandomDigits(length int) (string, error) {
+	return RandomString(length, CharsetDigits)
+}
+
+// RandomNumber returns a uniform random number with at most length digits, in [0, 10^length).
+//
+// Parameters:
+// - length: The number of digits, from 1 to 18.
+//
+// Returns:
+// - The random number, and an error if the length is out of range or the system has no entropy.
+func RandomNumber(length int) (int, error) {
+	if length < 1 || length > maxRandomNumberLength {
+		return 0, ErrInvalidRandomLength
+	}
+
+	max := int64(1)
+	for i := 0; i < length; i++ {
+		max *= 10
+	}
+	n, err := RandomInt(max)
+	return int(n), err
+}
+
+// RandomPassword returns a random password of the given number of characters
+// that contains at least one character of each required charset. The other
+// characters are picked from all the charsets, and the result is shuffled.
+//
+// Parameters:
+// - length: The number of characters, at least the number of charsets.
+// - charsets: The required character classes. Defaults to CharsetLower,
+// CharsetUpper, CharsetDigits and CharsetSymbols.
+//
+// Returns:
+// - The random password, and an error if the length is too short, a charset is empty or the system has no entropy.
+func RandomPassword(length int, charsets ...string) (string, error) {
+	if len(charsets) == 0 {
+		charsets = []string{CharsetLower, CharsetUpper, CharsetDigits, CharsetSymbols}
+	}
+	if length < len(charsets) {
+		return "", ErrInvalidRandomLength
+	}
+
+	var all strings.Builder
+	out := make([]rune, 0, length)
+	for _, charset := range charsets {
+		if utf8.RuneCountInString(charset) == 0 {
+			return "", ErrEmptyCharset
+		}
+		all.WriteString(charset)
+
+		// Pick one character of each required charset.
+		c := make([]rune, 1)
+		if err := fillRandom(c, []rune(charset)); err != nil {
+			return "", err
+		}
+		out = append(out, c[0])
+	}
+
+	// Fill the rest from all the charsets.
+	rest := make([]rune, length-len(out))
+	if err := fillRandom(rest, []rune(all.String())); err != nil {
+		return "", err
+	}
+	out = append(out, rest...)
+
+	// Shuffle with Fisher-Yates, so the required characters are not always first.
+	for i := len(out) - 1; i > 0; i-- {
+		j, err := RandomInt(int64(i + 1))
+		if err != nil {
+			return "", err
+		}
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out), nil
+}
+
+// fillRandom fills out with characters picked uniformly from chars.
+//
+// Random bytes are drawn in batches and the ones that would favor the first
+// characters of chars (modulo bias) are rejected.
+func fillRandom(out []rune, chars []rune) error {
+	n := len(chars)
+	if n > 256 {
+		// Large charsets use big.Int sampling, one character at a time.
+		for i := range out {
+			idx, err := RandomInt(int64(n))
+			if err != nil {
+				return err
+			}
+			out[i] = chars[idx]
+		}
+		return nil
+	}
+
+	// Bytes from limit to 255 are rejected, so every index is equally likely.
+	limit := 256 - 256%n
+	buf := make([]byte, len(out)+len(out)/2+8)
+	for i := 0; i < len(out); {
+		if _, err := rand.Read(buf); err != nil {
+			return err
+		}
+		for _, b := range buf {
+			if int(b) >= limit {
+				continue
+			}
+			out[i] = chars[int(b)%n]
+			i++
+			if i == len(out) {
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package help
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// HeaderRequestID is the default HTTP header carrying the request ID.
+const HeaderRequestID = "X-Request-ID"
+
+// requestIDHeader is the HTTP header carrying the request ID, see SetRequestIDHeader.
+var requestIDHeader atomic.Pointer[string]
+
+// SetRequestIDHeader sets the HTTP header carrying the request ID, e.g.
+// X-Correlation-ID, which the outbound HTTP helpers forward and response
+// mapper reads when the context has no request ID. It applies to the whole
+// process, so call it once at startup.
+//
+// Parameters:
+// - header: The name of the header, an empty name restores HeaderRequestID.
+func SetRequestIDHeader(header string) {
+	if header == "" {
+		header = HeaderRequestID
+	}
+	requestIDHeader.Store(&header)
+}
+
+// RequestIDHeader returns the HTTP header carrying the request ID,
+// HeaderRequestID unless SetRequestIDHeader was called.
+func RequestIDHeader() string {
+	if header := requestIDHeader.Load(); header != nil {
+		return *header
+	}
+	return HeaderRequestID
+}
+
+// requestIDKey is the context key of the request ID.
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx that carries the request ID.
+//
+// Parameters:
+// - ctx: The parent context.
+// - requestID: The request ID to store.
+//
+// Returns:
+// - A context carrying the request ID.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx.
+//
+// Parameters:
+// - ctx: The context to read from.
+//
+// Returns:
+// - The request ID, or an empty string if ctx does not carry one.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
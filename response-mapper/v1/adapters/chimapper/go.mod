@@ -0,0 +1,25 @@
+module github.com/adamnasrudin03/go-helpers/response-mapper/v1/adapters/chimapper
+
+go 1.23
+
+require github.com/adamnasrudin03/go-helpers v0.0.0-00010101000000-000000000000
+
+require (
+	github.com/gabriel-vasile/mimetype v1.4.3 // indirect
+	github.com/go-chi/chi/v5 v5.3.2
+	github.com/go-playground/form v3.1.4+incompatible // indirect
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/go-playground/validator/v10 v10.22.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/leodido/go-urn v1.4.0 // indirect
+	golang.org/x/crypto v0.19.0 // indirect
+	golang.org/x/net v0.21.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The adapters are developed against the core module of this repository,
+// drop the replace once a tagged release of go-helpers is required instead.
+replace github.com/adamnasrudin03/go-helpers => ../../../..
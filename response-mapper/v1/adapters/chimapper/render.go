@@ -0,0 +1,46 @@
+// Package chimapper renders response-mapper v1 responses with chi.
+package chimapper
+
+import (
+	"net/http"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// Render renders the response based on the provided data, with the same
+// envelope as response_mapper.RenderJSON: *Pagination and *CursorPagination
+// fill meta and data, and a *ResponseError sets the status code from its error code.
+//
+// The request ID is looked up with response_mapper.RequestID, then falls back
+// to the one of chi's middleware.RequestID.
+func Render(w http.ResponseWriter, r *http.Request, statusCode int, v interface{}) {
+	if response_mapper.RequestID(r.Context(), w.Header()) == "" {
+		if requestID := middleware.GetReqID(r.Context()); requestID != "" {
+			if e, ok := v.(*response_mapper.ResponseError); ok && e.RequestID == "" {
+				withRequestID := *e
+				withRequestID.RequestID = requestID
+				v = &withRequestID
+			}
+		}
+	}
+
+	response_mapper.RenderJSON(w, statusCode, v, response_mapper.WithRequestContext(r.Context()))
+}
+
+// NotFound renders response_mapper.ErrRouteNotFound, to be used with chi.Router.NotFound.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	Render(w, r, http.StatusNotFound, response_mapper.ErrRouteNotFound())
+}
+
+// MethodNotAllowed renders response_mapper.ErrMethodNotAllowed, to be used with chi.Router.MethodNotAllowed.
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	Render(w, r, http.StatusMethodNotAllowed, response_mapper.ErrMethodNotAllowed())
+}
+
+// Mount registers NotFound and MethodNotAllowed on the router.
+func Mount(r chi.Router) {
+	r.NotFound(NotFound)
+	r.MethodNotAllowed(MethodNotAllowed)
+}
@@ -0,0 +1,62 @@
+// Package echomapper renders response-mapper v1 responses with Echo.
+package echomapper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+	"github.com/labstack/echo/v4"
+)
+
+// Render renders the response based on the provided data, with the same
+// envelope as response_mapper.RenderJSON: *Pagination and *CursorPagination
+// fill meta and data, and a *ResponseError sets the status code from its error code.
+// The request_id of errors is looked up in the request context and the response
+// header with response_mapper.RequestID.
+func Render(c echo.Context, statusCode int, v interface{}) error {
+	// Create the response structure and resolve the status code based on the input data
+	statusCode, resp := response_mapper.PrepareResponseContext(c.Request().Context(), c.Response().Header(), statusCode, v)
+
+	// Marshal the data to JSON format
+	d, err := json.Marshal(resp)
+	if err != nil {
+		c.Logger().Error(err)
+		statusCode, resp = response_mapper.PrepareResponseContext(c.Request().Context(), c.Response().Header(), http.StatusInternalServerError, response_mapper.ErrInternalServerError())
+		d, _ = json.Marshal(resp)
+	}
+
+	return c.Blob(statusCode, "application/json", d)
+}
+
+// HTTPErrorHandler is an echo.HTTPErrorHandler that renders returned errors
+// with the response-mapper envelope, to be set as echo.Echo.HTTPErrorHandler.
+//
+// A *ResponseError is rendered as is, an *echo.HTTPError as the error of its
+// status code (see response_mapper.ErrHTTPStatus), e.g. ErrRouteNotFound for
+// 404 or ErrMethodNotAllowed for 405, and any other error as ErrInternalServerError.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	var respErr *response_mapper.ResponseError
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &respErr):
+		err = respErr
+	case errors.As(err, &httpErr):
+		if httpErr.Code >= http.StatusInternalServerError {
+			c.Logger().Error(err)
+		}
+		err = response_mapper.ErrHTTPStatus(httpErr.Code).WithCauseNoLog(httpErr)
+	default:
+		c.Logger().Error(err)
+		err = response_mapper.ErrInternalServerError()
+	}
+
+	if renderErr := Render(c, http.StatusInternalServerError, err); renderErr != nil {
+		c.Logger().Error(renderErr)
+	}
+}
@@ -0,0 +1,51 @@
+package echomapper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"bad request", echo.NewHTTPError(http.StatusBadRequest, "code=400, message=Syntax error"), http.StatusBadRequest, "REQUEST_PARSE_FAILED"},
+		{"unauthorized", echo.ErrUnauthorized, http.StatusUnauthorized, "UNAUTHORIZED"},
+		{"not found", echo.ErrNotFound, http.StatusNotFound, "ROUTE_NOT_FOUND"},
+		{"method not allowed", echo.ErrMethodNotAllowed, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED"},
+		{"body limit", echo.ErrStatusRequestEntityTooLarge, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE"},
+		{"unsupported media type", echo.ErrUnsupportedMediaType, http.StatusUnsupportedMediaType, "HTTP_ERROR"},
+		{"other error", errors.New("boom"), http.StatusInternalServerError, "INTERNAL_SERVER_ERROR"},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+
+			HTTPErrorHandler(tt.err, c)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var body struct {
+				ErrorCode string `json:"error_code"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.ErrorCode != tt.wantCode {
+				t.Errorf("error_code = %q, want %q", body.ErrorCode, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package fibermapper renders response-mapper v1 responses with Fiber.
+package fibermapper
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Render renders the response based on the provided data, with the same
+// envelope as response_mapper.RenderJSON: *Pagination and *CursorPagination
+// fill meta and data, and a *ResponseError sets the status code from its error code.
+// The request_id of errors is looked up in c.UserContext() and the response
+// header with response_mapper.RequestID.
+func Render(c *fiber.Ctx, statusCode int, v interface{}) error {
+	// Fiber headers are not an http.Header, so copy the ones PrepareResponse reads and writes.
+	header := http.Header{}
+	header.Set(help.RequestIDHeader(), c.GetRespHeader(help.RequestIDHeader()))
+
+	// Create the response structure and resolve the status code based on the input data
+	statusCode, resp := response_mapper.PrepareResponseContext(c.UserContext(), header, statusCode, v)
+
+	// Marshal the data to JSON format
+	d, err := json.Marshal(resp)
+	if err != nil {
+		log.Printf("fibermapper-Render marshal error: %v \n", err)
+		statusCode, resp = response_mapper.PrepareResponseContext(c.UserContext(), header, http.StatusInternalServerError, response_mapper.ErrInternalServerError())
+		d, _ = json.Marshal(resp)
+	}
+
+	if link := header.Get("Link"); link != "" {
+		c.Set("Link", link)
+	}
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(statusCode).Send(d)
+}
+
+// ErrorHandler is a fiber.ErrorHandler that renders returned errors with the
+// response-mapper envelope, to be set as fiber.Config.ErrorHandler.
+//
+// A *ResponseError is rendered as is, a *fiber.Error as the error of its
+// status code (see response_mapper.ErrHTTPStatus), e.g. ErrRouteNotFound for
+// 404 or ErrMethodNotAllowed for 405, and any other error as ErrInternalServerError.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	var respErr *response_mapper.ResponseError
+	var fiberErr *fiber.Error
+	switch {
+	case errors.As(err, &respErr):
+		err = respErr
+	case errors.As(err, &fiberErr):
+		if fiberErr.Code >= fiber.StatusInternalServerError {
+			log.Printf("fibermapper-ErrorHandler: %v \n", err)
+		}
+		err = response_mapper.ErrHTTPStatus(fiberErr.Code).WithCauseNoLog(fiberErr)
+	default:
+		log.Printf("fibermapper-ErrorHandler: %v \n", err)
+		err = response_mapper.ErrInternalServerError()
+	}
+
+	return Render(c, http.StatusInternalServerError, err)
+}
@@ -0,0 +1,59 @@
+package fibermapper
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"bad request", fiber.ErrBadRequest, http.StatusBadRequest, "REQUEST_PARSE_FAILED"},
+		{"unauthorized", fiber.ErrUnauthorized, http.StatusUnauthorized, "UNAUTHORIZED"},
+		{"not found", fiber.ErrNotFound, http.StatusNotFound, "ROUTE_NOT_FOUND"},
+		{"method not allowed", fiber.ErrMethodNotAllowed, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED"},
+		{"body limit", fiber.ErrRequestEntityTooLarge, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE"},
+		{"too many requests", fiber.ErrTooManyRequests, http.StatusTooManyRequests, "RATE_LIMITED"},
+		{"unsupported media type", fiber.ErrUnsupportedMediaType, http.StatusUnsupportedMediaType, "HTTP_ERROR"},
+		{"other error", errors.New("boom"), http.StatusInternalServerError, "INTERNAL_SERVER_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+			app.Post("/", func(c *fiber.Ctx) error {
+				return tt.err
+			})
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/", nil))
+			if err != nil {
+				t.Fatalf("app.Test: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			data, _ := io.ReadAll(resp.Body)
+			var body struct {
+				ErrorCode string `json:"error_code"`
+			}
+			if err := json.Unmarshal(data, &body); err != nil {
+				t.Fatalf("decode body %q: %v", data, err)
+			}
+			if body.ErrorCode != tt.wantCode {
+				t.Errorf("error_code = %q, want %q", body.ErrorCode, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Package ginmapper renders response-mapper v1 responses with Gin.
+package ginmapper
+
+import (
+	"encoding/json"
+	"net/http"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// Render renders the response based on the provided data, with the same
+// envelope as response_mapper.RenderJSON: *Pagination and *CursorPagination
+// fill meta and data, and a *ResponseError sets the status code from its error code.
+// The request_id of errors is looked up in the request context and the response
+// header with response_mapper.RequestID.
+func Render(c *gin.Context, statusCode int, v interface{}) {
+	// Create the response structure and resolve the status code based on the input data
+	statusCode, resp := response_mapper.PrepareResponseContext(c.Request.Context(), c.Writer.Header(), statusCode, v)
+
+	// Marshal the data to JSON format
+	d, err := json.Marshal(resp)
+	if err != nil {
+		_ = c.Error(err)
+		statusCode, resp = response_mapper.PrepareResponseContext(c.Request.Context(), c.Writer.Header(), http.StatusInternalServerError, response_mapper.ErrInternalServerError())
+		d, _ = json.Marshal(resp)
+	}
+
+	c.Data(statusCode, "application/json", d)
+}
+
+// AbortWithError renders the error and stops the remaining handlers of the chain.
+func AbortWithError(c *gin.Context, err error) {
+	Render(c, http.StatusInternalServerError, err)
+	c.Abort()
+}
+
+// NoRoute is a gin.HandlerFunc that renders response_mapper.ErrRouteNotFound,
+// to be used with gin.Engine.NoRoute.
+func NoRoute(c *gin.Context) {
+	Render(c, http.StatusNotFound, response_mapper.ErrRouteNotFound())
+}
@@ -0,0 +1,34 @@
+package grpcmapper
+
+import (
+	"context"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor returns a server interceptor that converts the errors
+// returned by handlers into gRPC statuses with ToError.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToError(err)
+	}
+}
+
+// UnaryClientInterceptor returns a client interceptor that forwards the request
+// ID of the context as metadata named by help.RequestIDHeader and converts the status errors
+// returned by the server back into *ResponseError with FromError.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if requestID := help.RequestIDFromContext(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, help.RequestIDHeader(), requestID)
+		}
+
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return FromError(err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+// Package grpcmapper converts response-mapper v1 errors to and from gRPC statuses.
+package grpcmapper
+
+import (
+	"errors"
+	"strconv"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorInfoDomain is the domain of the errdetails.ErrorInfo attached to converted statuses.
+const ErrorInfoDomain = "github.com/adamnasrudin03/go-helpers"
+
+// Metadata keys of the errdetails.ErrorInfo attached to converted statuses.
+const (
+	metadataCode       = "code"
+	metadataHttpStatus = "http_status"
+	metadataRequestID  = "request_id"
+)
+
+// grpcCodeMapping maps error types to gRPC codes.
+var grpcCodeMapping = map[response_mapper.TypeError]codes.Code{
+	response_mapper.ErrForbidden:    codes.PermissionDenied,
+	response_mapper.ErrUnauthorized: codes.Unauthenticated,
+	response_mapper.ErrDatabase:     codes.Internal,
+	response_mapper.ErrConflict:     codes.AlreadyExists,
+	response_mapper.ErrFromUseCase:  codes.FailedPrecondition,
+	response_mapper.ErrValidation:   codes.InvalidArgument,
+	response_mapper.ErrNoFound:      codes.NotFound,
+	response_mapper.ErrUnknown:      codes.Internal,
+	response_mapper.ErrTimeout:      codes.DeadlineExceeded,
+	response_mapper.ErrNotAllowed:   codes.Unimplemented,
+	response_mapper.ErrRateLimit:    codes.ResourceExhausted,
+}
+
+// typeErrorMapping maps gRPC codes back to error types.
+var typeErrorMapping = map[codes.Code]response_mapper.TypeError{
+	codes.PermissionDenied:   response_mapper.ErrForbidden,
+	codes.Unauthenticated:    response_mapper.ErrUnauthorized,
+	codes.AlreadyExists:      response_mapper.ErrConflict,
+	codes.Aborted:            response_mapper.ErrConflict,
+	codes.FailedPrecondition: response_mapper.ErrFromUseCase,
+	codes.InvalidArgument:    response_mapper.ErrValidation,
+	codes.OutOfRange:         response_mapper.ErrValidation,
+	codes.NotFound:           response_mapper.ErrNoFound,
+	codes.DeadlineExceeded:   response_mapper.ErrTimeout,
+	codes.Unimplemented:      response_mapper.ErrNotAllowed,
+	codes.ResourceExhausted:  response_mapper.ErrRateLimit,
+}
+
+// CodeFromTypeError returns the gRPC code for the given error type.
+// Unknown error types map to codes.Internal.
+func CodeFromTypeError(t response_mapper.TypeError) codes.Code {
+	if code, ok := grpcCodeMapping[t]; ok {
+		return code
+	}
+	return codes.Internal
+}
+
+// TypeErrorFromCode returns the error type for the given gRPC code.
+// Codes without a matching type map to ErrUnknown.
+func TypeErrorFromCode(code codes.Code) response_mapper.TypeError {
+	if t, ok := typeErrorMapping[code]; ok {
+		return t
+	}
+	return response_mapper.ErrUnknown
+}
+
+// ToStatus converts a ResponseError into a gRPC status.
+//
+// The status message is the English message. The details carry both messages
+// as errdetails.LocalizedMessage and the error code, internal code, HTTP status
+// override and request ID as errdetails.ErrorInfo, so FromStatus can restore
+// the ResponseError.
+func ToStatus(e *response_mapper.ResponseError) *status.Status {
+	if e == nil {
+		return status.New(codes.OK, "")
+	}
+
+	st := status.New(CodeFromTypeError(response_mapper.TypeError(e.Code)), e.Message.EN)
+
+	metadata := map[string]string{
+		metadataCode: strconv.Itoa(e.Code),
+	}
+	if e.HttpStatus != 0 {
+		metadata[metadataHttpStatus] = strconv.Itoa(e.HttpStatus)
+	}
+	if e.RequestID != "" {
+		metadata[metadataRequestID] = e.RequestID
+	}
+
+	withDetails, err := st.WithDetails(
+		&errdetails.LocalizedMessage{Locale: help.LangEn, Message: e.Message.EN},
+		&errdetails.LocalizedMessage{Locale: help.LangID, Message: e.Message.ID},
+		&errdetails.ErrorInfo{Reason: e.ErrorCode, Domain: ErrorInfoDomain, Metadata: metadata},
+	)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// ToError converts an error into a gRPC status error.
+//
+// A *ResponseError anywhere in the chain is converted with ToStatus, an error
+// that already is a gRPC status is returned as is, and any other error is
+// converted from ErrInternalServerError, keeping it as the cause.
+func ToError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var respErr *response_mapper.ResponseError
+	if errors.As(err, &respErr) {
+		return ToStatus(respErr).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return ToStatus(response_mapper.ErrInternalServerError().WithCause(err)).Err()
+}
+
+// FromStatus converts a gRPC status back into a ResponseError.
+//
+// The messages are read from the errdetails.LocalizedMessage details and fall
+// back to the status message. The error type is read from the
+// errdetails.ErrorInfo details and falls back to TypeErrorFromCode.
+func FromStatus(st *status.Status) *response_mapper.ResponseError {
+	if st == nil || st.Code() == codes.OK {
+		return nil
+	}
+
+	var (
+		messages   = response_mapper.MultiLanguages{ID: st.Message(), EN: st.Message()}
+		typeError  = TypeErrorFromCode(st.Code())
+		errorCode  string
+		requestID  string
+		httpStatus int
+	)
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.LocalizedMessage:
+			switch d.GetLocale() {
+			case help.LangEn:
+				messages.EN = d.GetMessage()
+			case help.LangID:
+				messages.ID = d.GetMessage()
+			}
+		case *errdetails.ErrorInfo:
+			if d.GetDomain() != ErrorInfoDomain {
+				continue
+			}
+			errorCode = d.GetReason()
+			requestID = d.GetMetadata()[metadataRequestID]
+			httpStatus, _ = strconv.Atoi(d.GetMetadata()[metadataHttpStatus])
+			if code, err := strconv.Atoi(d.GetMetadata()[metadataCode]); err == nil {
+				typeError = response_mapper.TypeError(code)
+			}
+		}
+	}
+
+	respErr := response_mapper.NewError(typeError, response_mapper.NewResponseMultiLang(messages))
+	respErr.ErrorCode = errorCode
+	respErr.RequestID = requestID
+	if httpStatus != 0 {
+		respErr.HttpStatus = httpStatus
+		respErr.Status = response_mapper.StatusMapping(httpStatus)
+	}
+	respErr.Cause = st.Err()
+	return respErr
+}
+
+// FromError converts a gRPC status error back into a ResponseError.
+// Errors that are not gRPC statuses become ErrUnknown errors wrapping them.
+func FromError(err error) *response_mapper.ResponseError {
+	if err == nil {
+		return nil
+	}
+
+	var respErr *response_mapper.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return response_mapper.NewError(response_mapper.ErrUnknown, err)
+	}
+	return FromStatus(st)
+}
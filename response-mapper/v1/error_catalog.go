@@ -0,0 +1,247 @@
+package v1
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultCatalogFile holds the built-in error definitions used by the ErrXxx constructors.
+//
+//go:embed error_catalog.yaml
+var defaultCatalogFile []byte
+
+// DefaultCatalog is the error catalog used by every ErrXxx constructor in this package.
+// Applications can load their own files into it to add codes or override built-in messages.
+var DefaultCatalog = MustNewErrorCatalog(defaultCatalogFile)
+
+// ErrorDefinition describes a single error entry of the catalog.
+type ErrorDefinition struct {
+	// Code is the stable, machine-readable error code, e.g. "AUTH_OTP_EXPIRED".
+	Code string `json:"code" yaml:"code"`
+	// Type is the internal error type, written as the constant name in catalog files.
+	Type TypeError `json:"type" yaml:"type"`
+	// HttpStatus overrides the HTTP status derived from Type when it is not zero.
+	HttpStatus int `json:"http_status,omitempty" yaml:"http_status,omitempty"`
+	// Messages holds the message templates per language, formatted with fmt verbs.
+	Messages MultiLanguages `json:"messages" yaml:"messages"`
+}
+
+// errorCatalogFile is the structure of a catalog file.
+type errorCatalogFile struct {
+	Errors []ErrorDefinition `json:"errors" yaml:"errors"`
+}
+
+// ErrorCatalog is a registry of error definitions keyed by error code.
+type ErrorCatalog struct {
+	mu          sync.RWMutex
+	definitions map[string]ErrorDefinition
+}
+
+// NewErrorCatalog creates an empty ErrorCatalog.
+func NewErrorCatalog() *ErrorCatalog {
+	return &ErrorCatalog{
+		definitions: make(map[string]ErrorDefinition),
+	}
+}
+
+// MustNewErrorCatalog creates an ErrorCatalog from YAML data and panics if the data is invalid.
+// It is intended for catalogs embedded in the binary.
+func MustNewErrorCatalog(yamlData []byte) *ErrorCatalog {
+	c := NewErrorCatalog()
+	if err := c.LoadYAML(yamlData); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Register adds or replaces error definitions in the catalog.
+//
+// A definition must have a code and a known type. When only one language is
+// filled in, it is used for the other language as well.
+func (c *ErrorCatalog) Register(defs ...ErrorDefinition) error {
+	for i := range defs {
+		def := &defs[i]
+		def.Code = strings.TrimSpace(def.Code)
+		if def.Code == "" {
+			return fmt.Errorf("error catalog: definition at index %d has no code", i)
+		}
+		if _, ok := typeErrorNames[def.Type]; !ok {
+			return fmt.Errorf("error catalog: %s has unknown type %d", def.Code, def.Type)
+		}
+		if def.Messages.ID == "" {
+			def.Messages.ID = def.Messages.EN
+		}
+		if def.Messages.EN == "" {
+			def.Messages.EN = def.Messages.ID
+		}
+		if def.Messages.EN == "" {
+			return fmt.Errorf("error catalog: %s has no message", def.Code)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, def := range defs {
+		c.definitions[def.Code] = def
+	}
+	return nil
+}
+
+// LoadYAML registers the definitions found in YAML data.
+func (c *ErrorCatalog) LoadYAML(data []byte) error {
+	var file errorCatalogFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("error catalog: %w", err)
+	}
+	return c.Register(file.Errors...)
+}
+
+// LoadJSON registers the definitions found in JSON data.
+func (c *ErrorCatalog) LoadJSON(data []byte) error {
+	var file errorCatalogFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("error catalog: %w", err)
+	}
+	return c.Register(file.Errors...)
+}
+
+// LoadFS registers the definitions of every file in fsys matching one of the glob patterns.
+// Files ending in ".json" are decoded as JSON, everything else as YAML.
+// Files are loaded in lexical order, so later files override earlier ones.
+func (c *ErrorCatalog) LoadFS(fsys fs.FS, patterns ...string) error {
+	for _, pattern := range patterns {
+		files, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return fmt.Errorf("error catalog: %w", err)
+		}
+		sort.Strings(files)
+
+		for _, name := range files {
+			data, err := fs.ReadFile(fsys, name)
+			if err != nil {
+				return fmt.Errorf("error catalog: %w", err)
+			}
+
+			if strings.EqualFold(path.Ext(name), ".json") {
+				err = c.LoadJSON(data)
+			} else {
+				err = c.LoadYAML(data)
+			}
+			if err != nil {
+				return fmt.Errorf("%w (file %s)", err, name)
+			}
+		}
+	}
+	return nil
+}
+
+// Lookup returns the definition registered for the given code.
+func (c *ErrorCatalog) Lookup(code string) (ErrorDefinition, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	def, ok := c.definitions[code]
+	return def, ok
+}
+
+// Definitions returns every registered definition sorted by code.
+func (c *ErrorCatalog) Definitions() []ErrorDefinition {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	defs := make([]ErrorDefinition, 0, len(c.definitions))
+	for _, def := range c.definitions {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Code < defs[j].Code
+	})
+	return defs
+}
+
+// New renders the definition registered for code into a ResponseError.
+//
+// The message templates are formatted with args. An argument of type
+// MultiLanguages is localized, so the Indonesian template receives its ID
+// value and the English template its EN value. Unknown codes produce an
+// ErrUnknown error that still carries the requested code.
+func (c *ErrorCatalog) New(code string, args ...interface{}) *ResponseError {
+	def, ok := c.Lookup(code)
+	if !ok {
+		err := NewError(ErrUnknown, NewResponseMultiLang(MultiLanguages{
+			ID: fmt.Sprintf("Kode kesalahan %s tidak terdaftar", code),
+			EN: fmt.Sprintf("Error code %s is not registered", code),
+		}))
+		err.ErrorCode = code
+		return err
+	}
+
+	err := NewError(def.Type, NewResponseMultiLang(MultiLanguages{
+		ID: formatTemplate(def.Messages.ID, localizeArgs(args, true)),
+		EN: formatTemplate(def.Messages.EN, localizeArgs(args, false)),
+	}))
+	err.ErrorCode = def.Code
+	if def.HttpStatus != 0 {
+		err.HttpStatus = def.HttpStatus
+		err.Status = StatusMapping(def.HttpStatus)
+	}
+	return err
+}
+
+// NewFromCatalog renders an error from the DefaultCatalog.
+func NewFromCatalog(code string, args ...interface{}) *ResponseError {
+	return DefaultCatalog.New(code, args...)
+}
+
+// templateVerb matches the formatting verbs of a message template, e.g. %s or %d.
+var templateVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z]`)
+
+// formatTemplate formats a message template. Without arguments, the verbs of
+// the template are dropped, so "Data already exists%s" renders "Data already
+// exists" instead of leaking the verb to the client.
+func formatTemplate(template string, args []interface{}) string {
+	if len(args) == 0 {
+		if !strings.Contains(template, "%") {
+			return template
+		}
+		parts := strings.Split(template, "%%")
+		for i, part := range parts {
+			part = strings.Join(strings.Fields(templateVerb.ReplaceAllString(part, "")), " ")
+			parts[i] = strings.ReplaceAll(part, " )", ")")
+		}
+		return strings.Join(parts, "%")
+	}
+	return fmt.Sprintf(template, args...)
+}
+
+// localizeArgs replaces MultiLanguages arguments with their Indonesian or English value.
+func localizeArgs(args []interface{}, indonesian bool) []interface{} {
+	out := make([]interface{}, len(args))
+	for i, arg := range args {
+		var langs *MultiLanguages
+		switch v := arg.(type) {
+		case MultiLanguages:
+			langs = &v
+		case *MultiLanguages:
+			langs = v
+		}
+
+		switch {
+		case langs == nil:
+			out[i] = arg
+		case indonesian:
+			out[i] = langs.ID
+		default:
+			out[i] = langs.EN
+		}
+	}
+	return out
+}
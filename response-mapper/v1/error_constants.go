@@ -1,5 +1,10 @@
 package v1
 
+import (
+	"fmt"
+	"strings"
+)
+
 // TypeError is a type of custom error
 type TypeError uint16
 
@@ -13,4 +18,49 @@ const (
 	ErrValidation                         // 15, ErrValidation is used when there is an error with the validation
 	ErrNoFound                            // 16, ErrNoFound is used when the data is not found
 	ErrUnknown                            // 17, ErrUnknown is used when the error is unknown
+	ErrTimeout                            // 18, ErrTimeout is used when an operation takes longer than allowed
+	ErrNotAllowed                         // 19, ErrNotAllowed is used when the HTTP method is not supported by the resource
+	ErrRateLimit                          // 20, ErrRateLimit is used when the client exceeded a rate or attempt limit
 )
+
+// typeErrorNames maps each TypeError to the name used in error catalog files.
+var typeErrorNames = map[TypeError]string{
+	ErrForbidden:    "ErrForbidden",
+	ErrUnauthorized: "ErrUnauthorized",
+	ErrDatabase:     "ErrDatabase",
+	ErrConflict:     "ErrConflict",
+	ErrFromUseCase:  "ErrFromUseCase",
+	ErrValidation:   "ErrValidation",
+	ErrNoFound:      "ErrNoFound",
+	ErrUnknown:      "ErrUnknown",
+	ErrTimeout:      "ErrTimeout",
+	ErrNotAllowed:   "ErrNotAllowed",
+	ErrRateLimit:    "ErrRateLimit",
+}
+
+// String returns the constant name of the TypeError, e.g. "ErrValidation".
+func (t TypeError) String() string {
+	if name, ok := typeErrorNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("TypeError(%d)", uint16(t))
+}
+
+// Error implements the error interface, so a TypeError can be used as a
+// sentinel with errors.Is, e.g. errors.Is(err, ErrNoFound).
+func (t TypeError) Error() string {
+	return t.String()
+}
+
+// UnmarshalText parses a TypeError from its constant name, so error catalog
+// files can write `type: ErrValidation` instead of the numeric value.
+func (t *TypeError) UnmarshalText(text []byte) error {
+	name := strings.TrimSpace(string(text))
+	for k, v := range typeErrorNames {
+		if v == name {
+			*t = k
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown error type %q", name)
+}
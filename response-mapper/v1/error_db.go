@@ -1,41 +1,21 @@
 package v1
 
 func ErrDB() *ResponseError {
-	return NewError(ErrDatabase, NewResponseMultiLang(
-		MultiLanguages{
-			EN: "An error occurred while querying db",
-			ID: "Terjadi kesalahan pada saat query db",
-		}))
+	return NewFromCatalog("DB_QUERY_FAILED")
 }
 
 func ErrUpdatedDB() *ResponseError {
-	return NewError(ErrDatabase, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Terjadi kesalahan pada saat perbarui data ke db",
-			EN: "An error occurred while updating db",
-		}))
+	return NewFromCatalog("DB_UPDATE_FAILED")
 }
 
 func ErrCreatedDB() *ResponseError {
-	return NewError(ErrDatabase, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Terjadi kesalahan pada saat menambahkan data ke db",
-			EN: "An error occurred while creating db",
-		}))
+	return NewFromCatalog("DB_CREATE_FAILED")
 }
 
 func ErrDeletedDB() *ResponseError {
-	return NewError(ErrDatabase, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Terjadi kesalahan pada saat menghapus data ke db",
-			EN: "An error occurred while deleting db",
-		}))
+	return NewFromCatalog("DB_DELETE_FAILED")
 }
 
 func ErrFailedSendEmail() *ResponseError {
-	return NewError(ErrDatabase, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Gagal mengirim surel",
-			EN: "Failed to send email",
-		}))
+	return NewFromCatalog("EMAIL_SEND_FAILED")
 }
@@ -0,0 +1,265 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"reflect"
+	"regexp"
+)
+
+// SQLStateError is implemented by database errors that expose a SQLSTATE code,
+// such as *pgconn.PgError (pgx) and *pq.Error (lib/pq).
+type SQLStateError interface {
+	SQLState() string
+}
+
+// MySQLNumberError is implemented by database errors that expose a MySQL error
+// number, e.g. 1062 for ER_DUP_ENTRY. *mysql.MySQLError (go-sql-driver/mysql)
+// exposes it as its Number field instead, which MapDBError reads as a fallback.
+type MySQLNumberError interface {
+	MySQLNumber() uint16
+}
+
+// ConstraintError is implemented by database errors that expose the name of
+// the violated constraint.
+type ConstraintError interface {
+	ConstraintName() string
+}
+
+// The list of database error kinds recognized by MapDBError.
+const (
+	DBErrUnknown         = "unknown"
+	DBErrNotFound        = "not_found"
+	DBErrUniqueViolation = "unique_violation"
+	DBErrForeignKey      = "foreign_key_violation"
+	DBErrDeadlock        = "deadlock"
+	DBErrTimeout         = "timeout"
+)
+
+// sqlStateKinds maps SQLSTATE codes (PostgreSQL) to database error kinds.
+var sqlStateKinds = map[string]string{
+	"23505": DBErrUniqueViolation, // unique_violation
+	"23503": DBErrForeignKey,      // foreign_key_violation
+	"40P01": DBErrDeadlock,        // deadlock_detected
+	"40001": DBErrDeadlock,        // serialization_failure
+	"57014": DBErrTimeout,         // query_canceled, raised by statement_timeout
+	"55P03": DBErrTimeout,         // lock_not_available, raised by lock_timeout
+}
+
+// mysqlNumberKinds maps MySQL error numbers to database error kinds.
+var mysqlNumberKinds = map[uint16]string{
+	1062: DBErrUniqueViolation, // ER_DUP_ENTRY
+	1586: DBErrUniqueViolation, // ER_DUP_ENTRY_WITH_KEY_NAME
+	1451: DBErrForeignKey,      // ER_ROW_IS_REFERENCED_2
+	1452: DBErrForeignKey,      // ER_NO_REFERENCED_ROW_2
+	1213: DBErrDeadlock,        // ER_LOCK_DEADLOCK
+	1205: DBErrTimeout,         // ER_LOCK_WAIT_TIMEOUT
+	3024: DBErrTimeout,         // ER_QUERY_TIMEOUT
+}
+
+var (
+	// mysqlDuplicateKeyRegex extracts the key name of a MySQL duplicate entry message.
+	mysqlDuplicateKeyRegex = regexp.MustCompile("for key '([^']+)'")
+	// mysqlForeignKeyRegex extracts the constraint name of a MySQL foreign key message.
+	mysqlForeignKeyRegex = regexp.MustCompile("CONSTRAINT `([^`]+)`")
+)
+
+// ClassifyDBError returns the kind of a database error and, where the driver
+// reports it, the name of the violated constraint.
+//
+// Driver errors are recognized without importing the drivers, through the
+// SQLStateError, MySQLNumberError and ConstraintError interfaces. Errors that
+// implement none of them fall back to reflection on the exported fields used
+// by the common PostgreSQL and MySQL drivers: a 5 character Code, a Number and
+// ConstraintName or Constraint. The whole error tree is searched, including
+// errors.Join and fmt.Errorf with several %w verbs.
+func ClassifyDBError(err error) (kind string, constraint string) {
+	if err == nil {
+		return DBErrUnknown, ""
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return DBErrNotFound, ""
+	}
+
+	if kind, constraint, ok := classifyDriverError(err); ok {
+		return kind, constraint
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return DBErrTimeout, ""
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return DBErrTimeout, ""
+	}
+
+	return DBErrUnknown, ""
+}
+
+// classifyDriverError walks the error tree like errors.As, following both
+// Unwrap() error and Unwrap() []error, and classifies the first driver error
+// with a known SQLSTATE or MySQL error number.
+func classifyDriverError(err error) (kind string, constraint string, ok bool) {
+	if err == nil {
+		return "", "", false
+	}
+
+	if k, found := sqlStateKinds[sqlState(err)]; found {
+		return k, constraintName(err), true
+	}
+	if k, found := mysqlNumberKinds[mysqlNumber(err)]; found {
+		return k, constraintName(err), true
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return classifyDriverError(u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, e := range u.Unwrap() {
+			if kind, constraint, ok = classifyDriverError(e); ok {
+				return kind, constraint, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// MapDBError maps a database error to the ResponseError matching its kind.
+//
+// sql.ErrNoRows becomes a not found error, unique and foreign key violations
+// and deadlocks become conflicts, and timeouts become ErrTimeout. Any other
+// error becomes ErrDB. The original error is kept as the cause, so it still
+// matches errors.Is, but it is never rendered to the client. Only the causes
+// of ErrDB are logged, the other kinds are expected traffic.
+// A nil error returns nil and a *ResponseError is returned unchanged.
+func MapDBError(err error) *ResponseError {
+	if err == nil {
+		return nil
+	}
+
+	var respErr *ResponseError
+	if errors.As(err, &respErr) {
+		return respErr
+	}
+
+	kind, constraint := ClassifyDBError(err)
+	switch kind {
+	case DBErrNotFound:
+		respErr = ErrNotFound()
+	case DBErrUniqueViolation:
+		respErr = NewFromCatalog("DB_UNIQUE_VIOLATION", constraintDetail(constraint))
+	case DBErrForeignKey:
+		respErr = NewFromCatalog("DB_FOREIGN_KEY_VIOLATION", constraintDetail(constraint))
+	case DBErrDeadlock:
+		respErr = NewFromCatalog("DB_DEADLOCK")
+	case DBErrTimeout:
+		respErr = NewFromCatalog("DB_TIMEOUT")
+	default:
+		return ErrDB().WithCause(err)
+	}
+
+	return respErr.WithCauseNoLog(err)
+}
+
+// constraintDetail returns the localized constraint suffix of a violation message.
+func constraintDetail(constraint string) MultiLanguages {
+	if constraint == "" {
+		return MultiLanguages{}
+	}
+	return MultiLanguages{
+		ID: fmt.Sprintf(" (batasan %s)", constraint),
+		EN: fmt.Sprintf(" (constraint %s)", constraint),
+	}
+}
+
+// sqlState returns the SQLSTATE code of a single error, or an empty string.
+func sqlState(err error) string {
+	if e, ok := err.(SQLStateError); ok {
+		return e.SQLState()
+	}
+	// Fallback for drivers without SQLState, e.g. the Code field of *pq.Error.
+	if code, ok := dbErrorStringField(err, "Code"); ok && len(code) == 5 {
+		return code
+	}
+	return ""
+}
+
+// mysqlNumber returns the MySQL error number of a single error, or 0.
+func mysqlNumber(err error) uint16 {
+	if e, ok := err.(MySQLNumberError); ok {
+		return e.MySQLNumber()
+	}
+	// Fallback for drivers without MySQLNumber, e.g. the Number field of *mysql.MySQLError.
+	if number, ok := dbErrorUintField(err, "Number"); ok && number <= math.MaxUint16 {
+		return uint16(number)
+	}
+	return 0
+}
+
+// constraintName returns the name of the violated constraint of a single error, or an empty string.
+func constraintName(err error) string {
+	if e, ok := err.(ConstraintError); ok {
+		return e.ConstraintName()
+	}
+	for _, field := range []string{"ConstraintName", "Constraint"} {
+		if name, ok := dbErrorStringField(err, field); ok && name != "" {
+			return name
+		}
+	}
+
+	// MySQL only reports the key or constraint name inside the message.
+	for _, re := range []*regexp.Regexp{mysqlDuplicateKeyRegex, mysqlForeignKeyRegex} {
+		if match := re.FindStringSubmatch(err.Error()); len(match) == 2 {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+// dbErrorField returns the exported struct field name of an error value.
+func dbErrorField(err error, name string) (reflect.Value, bool) {
+	v := reflect.ValueOf(err)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := v.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return reflect.Value{}, false
+	}
+	return field, true
+}
+
+// dbErrorStringField returns a string-kinded struct field of an error value.
+func dbErrorStringField(err error, name string) (string, bool) {
+	field, ok := dbErrorField(err, name)
+	if !ok || field.Kind() != reflect.String {
+		return "", false
+	}
+	return field.String(), true
+}
+
+// dbErrorUintField returns an unsigned integer struct field of an error value.
+func dbErrorUintField(err error, name string) (uint64, bool) {
+	field, ok := dbErrorField(err, name)
+	if !ok {
+		return 0, false
+	}
+	switch field.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field.Uint(), true
+	}
+	return 0, false
+}
@@ -1,76 +1,45 @@
 package v1
 
-import "fmt"
-
 func ErrReadContext() *ResponseError {
-	return NewError(ErrForbidden, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Gagal membaca data konteks",
-			EN: "Failed to read context data",
-		}))
+	return NewFromCatalog("CONTEXT_READ_FAILED")
 }
 
 func ErrNotFound() *ResponseError {
-	return NewError(ErrNoFound, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Data tidak ditemukan",
-			EN: "Data not found",
-		}))
+	return NewFromCatalog("DATA_NOT_FOUND")
 }
 
 func ErrDataNotFound(id, en string) *ResponseError {
-	return NewError(ErrNoFound, NewResponseMultiLang(
-		MultiLanguages{
-			ID: fmt.Sprintf("Data %s tidak ditemukan", id),
-			EN: fmt.Sprintf("Data %s not found", en),
-		}))
+	return NewFromCatalog("DATA_NOT_FOUND_NAMED", MultiLanguages{ID: id, EN: en})
 }
 
 func ErrNotAccess() *ResponseError {
-	return NewError(ErrForbidden, NewResponseMultiLang(MultiLanguages{
-		ID: "Tidak ada akses untuk data ini",
-		EN: "You don't have access to this data",
-	}))
+	return NewFromCatalog("ACCESS_DENIED")
 }
 
 func ErrUnmarshalJSON() *ResponseError {
-	return NewError(ErrUnknown, NewResponseMultiLang(MultiLanguages{
-		ID: "Gagal membatalkan marshal JSON",
-		EN: "Failed to unmarshal JSON",
-	}))
+	return NewFromCatalog("JSON_UNMARSHAL_FAILED")
 }
 
 func ErrOtpExpired() *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: "Kode OTP sudah kedaluwarsa",
-		EN: "OTP code has expired",
-	}))
+	return NewFromCatalog("AUTH_OTP_EXPIRED")
 }
 
 func ErrGenerateOtp() *ResponseError {
-	return NewError(ErrUnknown, NewResponseMultiLang(MultiLanguages{
-		ID: "Gagal membuat kode OTP",
-		EN: "Failed to generate OTP code",
-	}))
+	return NewFromCatalog("AUTH_OTP_GENERATE_FAILED")
 }
 
 func ErrOtpInvalid() *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: "Kode OTP tidak valid",
-		EN: "Invalid OTP code",
-	}))
+	return NewFromCatalog("AUTH_OTP_INVALID")
+}
+
+func ErrOtpTooManyAttempts() *ResponseError {
+	return NewFromCatalog("AUTH_OTP_TOO_MANY_ATTEMPTS")
 }
 
 func ErrEmailIsVerified() *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: "Email sudah terverifikasi",
-		EN: "Email is already verified",
-	}))
+	return NewFromCatalog("AUTH_EMAIL_ALREADY_VERIFIED")
 }
 
 func ErrEmailNotVerified() *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: "Email belum terverifikasi",
-		EN: "Email has not been verified",
-	}))
+	return NewFromCatalog("AUTH_EMAIL_NOT_VERIFIED")
 }
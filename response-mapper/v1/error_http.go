@@ -1,6 +1,9 @@
 package v1
 
-import "strings"
+import (
+	"net/http"
+	"strings"
+)
 
 // ResponseErrorHttp represents the structure of an error response from an HTTP request.
 type ResponseErrorHttp struct {
@@ -33,17 +36,55 @@ func (m *ResponseErrorHttp) GetMessageEN() string {
 }
 
 func ErrRouteNotFound() *ResponseError {
-	return NewError(ErrNoFound, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Rute tidak ditemukan",
-			EN: "Route not found",
-		}))
+	return NewFromCatalog("ROUTE_NOT_FOUND")
+}
+
+func ErrMethodNotAllowed() *ResponseError {
+	return NewFromCatalog("METHOD_NOT_ALLOWED")
 }
 
 func ErrInternalServerError() *ResponseError {
-	return NewError(ErrUnknown, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Internal Server Error",
-			EN: "Internal Server Error",
-		}))
+	return NewFromCatalog("INTERNAL_SERVER_ERROR")
+}
+
+// ErrRemoteResponseInvalid is returned by the decode helpers when a remote
+// service answers with a body that is not a response-mapper envelope.
+func ErrRemoteResponseInvalid(statusCode int) *ResponseError {
+	return NewFromCatalog("REMOTE_RESPONSE_INVALID", statusCode)
+}
+
+// ErrHTTPStatus returns the ResponseError matching an HTTP status code, e.g.
+// the code of a framework error such as *echo.HTTPError or *fiber.Error.
+// A status without a matching error keeps its status code as HTTP_ERROR.
+//
+// Parameters:
+// - statusCode: The HTTP status code of the error.
+//
+// Returns:
+// - The matching *ResponseError.
+func ErrHTTPStatus(statusCode int) *ResponseError {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return ErrGetRequest()
+	case http.StatusUnauthorized:
+		return NewFromCatalog("UNAUTHORIZED")
+	case http.StatusForbidden:
+		return ErrNotAccess()
+	case http.StatusNotFound:
+		return ErrRouteNotFound()
+	case http.StatusMethodNotAllowed:
+		return ErrMethodNotAllowed()
+	case http.StatusRequestEntityTooLarge:
+		return NewFromCatalog("REQUEST_TOO_LARGE")
+	case http.StatusTooManyRequests:
+		return NewFromCatalog("RATE_LIMITED")
+	case http.StatusInternalServerError:
+		return ErrInternalServerError()
+	}
+
+	err := NewFromCatalog("HTTP_ERROR", statusCode)
+	if statusCode >= 400 && statusCode <= 599 {
+		err.HttpStatus = statusCode
+	}
+	return err
 }
@@ -1,8 +1,5 @@
 package v1
 
 func ErrFailedTranslateText() *ResponseError {
-	return NewError(ErrUnknown, NewResponseMultiLang(MultiLanguages{
-		ID: "Gagal menerjemahkan teks",
-		EN: "Failed to translate text",
-	}))
+	return NewFromCatalog("TRANSLATE_FAILED")
 }
@@ -1,24 +1,13 @@
 package v1
 
-import "fmt"
-
 func ErrMustBeMoreThanZero(id, en string) *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: fmt.Sprintf("%s harus lebih dari 0", id),
-		EN: fmt.Sprintf("%s must be more than 0", en),
-	}))
+	return NewFromCatalog("VALIDATION_MUST_BE_MORE_THAN_ZERO", MultiLanguages{ID: id, EN: en})
 }
 
 func ErrCannotBeMoreThan(id, en, max string) *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: fmt.Sprintf("%s tidak boleh lebih dari %s", id, max),
-		EN: fmt.Sprintf("%s cannot be more than %s", en, max),
-	}))
+	return NewFromCatalog("VALIDATION_CANNOT_BE_MORE_THAN", MultiLanguages{ID: id, EN: en}, max)
 }
 
 func ErrIsDuplicate(id, en string) *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: fmt.Sprintf("%s sudah ada", id),
-		EN: fmt.Sprintf("%s already exists", en),
-	}))
+	return NewFromCatalog("VALIDATION_DUPLICATE", MultiLanguages{ID: id, EN: en})
 }
@@ -1,42 +1,67 @@
 package v1
 
+import (
+	"strconv"
+	"strings"
+
+	help "github.com/adamnasrudin03/go-helpers"
+)
+
 func ErrPasswordNotMatch() error {
-	return NewError(ErrValidation, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Kata sandi tidak sesuai",
-			EN: "Password not match",
-		},
-	))
+	return NewFromCatalog("AUTH_PASSWORD_NOT_MATCH")
 }
 
 func ErrNewPasswordNotMatchWithConfirmPassword() error {
-	return NewError(ErrValidation, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Kata sandi baru tidak sesuai dengan kata sandi konfirmasi",
-			EN: "New password not match with confirmation password",
-		},
-	))
+	return NewFromCatalog("AUTH_NEW_PASSWORD_NOT_MATCH_CONFIRMATION")
 }
 
 func ErrHashPasswordFailed() error {
-	return NewError(ErrFromUseCase, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Gagal hash kata sandi",
-			EN: "Failed to hash password",
-		},
-	))
+	return NewFromCatalog("AUTH_HASH_PASSWORD_FAILED")
+}
+
+// ErrPasswordPolicy returns the violations of help.PasswordPolicy.Check as a
+// validation error, with the details of every violation in Errors.
+func ErrPasswordPolicy(violations []help.PasswordViolation) *ResponseError {
+	idn := make([]string, len(violations))
+	enUs := make([]string, len(violations))
+	details := make([]ErrorDetail, len(violations))
+	for i, v := range violations {
+		idn[i] = v.Message(help.LangID)
+		enUs[i] = v.Message(help.LangEn)
+		details[i] = ErrorDetail{
+			Field:   "password",
+			Tag:     string(v.Rule),
+			Message: MultiLanguages{ID: idn[i], EN: enUs[i]},
+		}
+		if v.Param != 0 {
+			details[i].Param = strconv.Itoa(v.Param)
+		}
+	}
+
+	err := NewFromCatalog("AUTH_PASSWORD_POLICY", MultiLanguages{
+		ID: strings.Join(idn, ", "),
+		EN: strings.Join(enUs, ", "),
+	})
+	err.Errors = details
+	return err
 }
 
 func ErrCannotHaveAccessUpdateData() *ResponseError {
-	return NewError(ErrForbidden, NewResponseMultiLang(MultiLanguages{
-		ID: "Tidak memiliki akses untuk mengubah data",
-		EN: "Does not have access to change data",
-	}))
+	return NewFromCatalog("ACCESS_UPDATE_DATA_DENIED")
 }
 
 func ErrCannotHaveAccessResources() *ResponseError {
-	return NewError(ErrForbidden, NewResponseMultiLang(MultiLanguages{
-		ID: "Anda tidak diizinkan untuk mengakses sumber daya ini",
-		EN: "You are not allowed to access this resources",
-	}))
+	return NewFromCatalog("ACCESS_RESOURCE_DENIED")
+}
+
+func ErrTokenMissing() *ResponseError {
+	return NewFromCatalog("AUTH_TOKEN_MISSING")
+}
+
+func ErrTokenInvalid() *ResponseError {
+	return NewFromCatalog("AUTH_TOKEN_INVALID")
+}
+
+func ErrTokenExpired() *ResponseError {
+	return NewFromCatalog("AUTH_TOKEN_EXPIRED")
 }
@@ -1,21 +1,9 @@
 package v1
 
-import "fmt"
-
 func ErrInvalid(id, en string) *ResponseError {
-	// Construct the error message using the provided arguments.
-	errMsg := MultiLanguages{
-		ID: fmt.Sprintf("%s tidak valid", id),
-		EN: fmt.Sprintf("Invalid %s", en),
-	}
-
-	// Create and return the error.
-	return NewError(ErrValidation, NewResponseMultiLang(errMsg))
+	return NewFromCatalog("VALIDATION_INVALID", MultiLanguages{ID: id, EN: en})
 }
 
 func ErrInvalidFormat(id, en string) *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: fmt.Sprintf("Format %s tidak valid", id),
-		EN: fmt.Sprintf("Invalid %s format", en),
-	}))
+	return NewFromCatalog("VALIDATION_INVALID_FORMAT", MultiLanguages{ID: id, EN: en})
 }
@@ -1,16 +1,9 @@
 package v1
 
 func ErrGetRequest() *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(
-		MultiLanguages{
-			ID: "Gagal membaca request data",
-			EN: "Failed to parse data",
-		}))
+	return NewFromCatalog("REQUEST_PARSE_FAILED")
 }
 
 func ErrCannotUpdateData() *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: "Tidak diperbolehkan mengubah data",
-		EN: "Changing data is not allowed",
-	}))
+	return NewFromCatalog("DATA_UPDATE_NOT_ALLOWED")
 }
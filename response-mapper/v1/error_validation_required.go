@@ -1,17 +1,9 @@
 package v1
 
-import "fmt"
-
 func ErrIsRequired(id, en string) *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: fmt.Sprintf("%s harus diisi", id),
-		EN: fmt.Sprintf("%s is required", en),
-	}))
+	return NewFromCatalog("VALIDATION_REQUIRED", MultiLanguages{ID: id, EN: en})
 }
 
 func ErrIsEmpty(id, en string) *ResponseError {
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
-		ID: fmt.Sprintf("%s tidak ada isinya", id),
-		EN: fmt.Sprintf("%s is empty", en),
-	}))
+	return NewFromCatalog("VALIDATION_EMPTY", MultiLanguages{ID: id, EN: en})
 }
@@ -0,0 +1,296 @@
+// Package openapi generates OpenAPI 3.1 documents for the response envelope
+// of response-mapper v1, its data wrappers and the errors of the error catalog.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+)
+
+// Version is the OpenAPI version of the generated documents.
+const Version = "3.1.0"
+
+// Names of the component schemas of the envelope.
+const (
+	SchemaResponseDefault = "ResponseDefault"
+	SchemaResponseError   = "ResponseError"
+	SchemaMeta            = "Meta"
+	SchemaMultiLanguages  = "MultiLanguages"
+)
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string                 `json:"openapi"`
+	Info       Info                   `json:"info"`
+	Paths      map[string]interface{} `json:"paths,omitempty"`
+	Components Components             `json:"components"`
+}
+
+// Info holds the metadata of the API.
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Components holds the reusable schemas and responses of a Document.
+type Components struct {
+	Schemas   map[string]*Schema   `json:"schemas,omitempty"`
+	Responses map[string]*Response `json:"responses,omitempty"`
+}
+
+// Response is an OpenAPI response object.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType describes the body of a response.
+type MediaType struct {
+	Schema   *Schema             `json:"schema,omitempty"`
+	Examples map[string]*Example `json:"examples,omitempty"`
+}
+
+// Example is a named example of a response body.
+type Example struct {
+	Summary string      `json:"summary,omitempty"`
+	Value   interface{} `json:"value"`
+}
+
+// OptionGenerator is a function type used for applying options to the Generator.
+type OptionGenerator func(*Generator)
+
+// WithCatalog sets the error catalog whose errors are documented.
+// Defaults to response_mapper.DefaultCatalog.
+func WithCatalog(catalog *response_mapper.ErrorCatalog) OptionGenerator {
+	return func(g *Generator) {
+		g.catalog = catalog
+	}
+}
+
+// Generator collects the component schemas of an API.
+type Generator struct {
+	catalog *response_mapper.ErrorCatalog
+	schemas map[string]*Schema
+	names   map[reflect.Type]string // names holds the component name of every registered struct type.
+}
+
+// NewGenerator creates a Generator with the envelope schemas registered.
+func NewGenerator(options ...OptionGenerator) *Generator {
+	g := &Generator{
+		catalog: response_mapper.DefaultCatalog,
+		schemas: map[string]*Schema{},
+		names:   map[reflect.Type]string{},
+	}
+	// Apply any passed options to the generator.
+	for _, o := range options {
+		o(g)
+	}
+
+	g.registerEnvelope()
+	return g
+}
+
+// registerEnvelope registers the schemas of ResponseDefault, ResponseError, Meta and MultiLanguages.
+func (g *Generator) registerEnvelope() {
+	g.schemaOf(reflect.TypeOf(response_mapper.MultiLanguages{}))
+	g.schemaOf(reflect.TypeOf(response_mapper.Meta{}))
+	g.schemaOf(reflect.TypeOf(response_mapper.ResponseError{}))
+
+	codes := make([]interface{}, 0, len(typeErrors))
+	for _, t := range typeErrors {
+		codes = append(codes, int(t))
+	}
+	errSchema := g.schemas[SchemaResponseError]
+	errSchema.Description = "Error response, the status code is derived from code unless the error code overrides it."
+	errSchema.Properties["code"].Enum = codes
+
+	// The message, meta and data of ResponseDefault are interface{}, so describe them by hand.
+	g.schemas[SchemaResponseDefault] = &Schema{
+		Type:        "object",
+		Description: "Success response envelope.",
+		Properties: map[string]*Schema{
+			"status": {Type: "string", Examples: []interface{}{response_mapper.StatusMapping(http.StatusOK)}},
+			"message": {OneOf: []*Schema{
+				{Type: "string"},
+				Ref(SchemaMultiLanguages),
+			}},
+			"meta": Ref(SchemaMeta),
+			"data": {},
+		},
+		Required: []string{"status"},
+	}
+}
+
+// typeErrors lists the error types documented in the code enum of ResponseError.
+var typeErrors = []response_mapper.TypeError{
+	response_mapper.ErrForbidden,
+	response_mapper.ErrUnauthorized,
+	response_mapper.ErrDatabase,
+	response_mapper.ErrConflict,
+	response_mapper.ErrFromUseCase,
+	response_mapper.ErrValidation,
+	response_mapper.ErrNoFound,
+	response_mapper.ErrUnknown,
+	response_mapper.ErrTimeout,
+	response_mapper.ErrNotAllowed,
+	response_mapper.ErrRateLimit,
+}
+
+// Register adds the schema of the type of v to the components and returns a reference to it.
+// Unnamed and non-struct types are returned inline.
+func (g *Generator) Register(v interface{}) *Schema {
+	return g.schemaOf(reflect.TypeOf(v))
+}
+
+// DataResponse registers the envelope of a single T in data, as rendered by
+// RenderJSON(w, statusCode, T), and returns a reference to it.
+func DataResponse[T any](g *Generator) *Schema {
+	data := g.schemaOf(reflect.TypeOf((*T)(nil)).Elem())
+	return g.wrapper("Response_"+dataName(data), map[string]*Schema{
+		"data": data,
+	}, "data")
+}
+
+// PaginatedResponse registers the envelope of a *Pagination of T, with the
+// meta and the list of T in data, and returns a reference to it.
+func PaginatedResponse[T any](g *Generator) *Schema {
+	data := g.schemaOf(reflect.TypeOf((*T)(nil)).Elem())
+	return g.wrapper("PaginatedResponse_"+dataName(data), map[string]*Schema{
+		"meta": Ref(SchemaMeta),
+		"data": {Type: "array", Items: data},
+	}, "meta", "data")
+}
+
+// CursorResponse registers the envelope of a *CursorPagination of T, with the
+// cursor meta and the list of T in data, and returns a reference to it.
+func CursorResponse[T any](g *Generator) *Schema {
+	data := g.schemaOf(reflect.TypeOf((*T)(nil)).Elem())
+	return g.wrapper("CursorResponse_"+dataName(data), map[string]*Schema{
+		"meta": Ref(SchemaMeta),
+		"data": {Type: "array", Items: data},
+	}, "meta", "data")
+}
+
+// wrapper registers a schema that narrows ResponseDefault with the given properties.
+func (g *Generator) wrapper(name string, properties map[string]*Schema, required ...string) *Schema {
+	g.schemas[name] = &Schema{
+		AllOf: []*Schema{
+			Ref(SchemaResponseDefault),
+			{Type: "object", Properties: properties, Required: required},
+		},
+	}
+	return Ref(name)
+}
+
+// dataName returns the name used for the wrapper of a data schema.
+func dataName(s *Schema) string {
+	if s.Ref != "" {
+		return s.Ref[len("#/components/schemas/"):]
+	}
+	switch typ := s.Type.(type) {
+	case string:
+		return typ
+	case []string:
+		return typ[0]
+	}
+	return "Any"
+}
+
+// ErrorResponses returns one response per HTTP status used by the errors of
+// the catalog, keyed by status code. Every error of the catalog is an example
+// of the response of its status, which is the HTTP status of the definition
+// or the one mapped from its type by StatusErrorMapping.
+func (g *Generator) ErrorResponses() map[int]*Response {
+	responses := map[int]*Response{}
+	for _, def := range g.catalog.Definitions() {
+		err := g.catalog.New(def.Code, examplePlaceholders(def.Messages)...)
+		status := err.StatusCode()
+
+		resp, ok := responses[status]
+		if !ok {
+			resp = &Response{
+				Description: http.StatusText(status),
+				Content: map[string]MediaType{
+					"application/json": {
+						Schema:   Ref(SchemaResponseError),
+						Examples: map[string]*Example{},
+					},
+				},
+			}
+			responses[status] = resp
+		}
+		resp.Content["application/json"].Examples[def.Code] = &Example{
+			Summary: err.Message.EN,
+			Value:   err,
+		}
+	}
+	return responses
+}
+
+// templateVerb matches the formatting verbs of a message template, e.g. %s or %d.
+var templateVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z]`)
+
+// placeholder is an example argument of a message template. It renders as
+// {argN} whatever the verb, so "Data %s not found" reads "Data {arg1} not found".
+type placeholder int
+
+// Format implements fmt.Formatter.
+func (p placeholder) Format(f fmt.State, _ rune) {
+	fmt.Fprintf(f, "{arg%d}", int(p))
+}
+
+// examplePlaceholders returns one placeholder per verb of the longest message template.
+func examplePlaceholders(messages response_mapper.MultiLanguages) []interface{} {
+	n := 0
+	for _, template := range []string{messages.ID, messages.EN} {
+		if c := len(templateVerb.FindAllString(strings.ReplaceAll(template, "%%", ""), -1)); c > n {
+			n = c
+		}
+	}
+
+	args := make([]interface{}, n)
+	for i := range args {
+		args[i] = placeholder(i + 1)
+	}
+	return args
+}
+
+// ErrorResponseRef returns the reference of the component response of an error status, e.g. Error404.
+func ErrorResponseRef(status int) map[string]string {
+	return map[string]string{"$ref": "#/components/responses/" + errorResponseName(status)}
+}
+
+// errorResponseName returns the component name of the response of an error status.
+func errorResponseName(status int) string {
+	return "Error" + strconv.Itoa(status)
+}
+
+// Schemas returns the registered component schemas.
+func (g *Generator) Schemas() map[string]*Schema {
+	return g.schemas
+}
+
+// Document returns an OpenAPI document with the registered schemas and the
+// error responses in its components, ready to be encoded with encoding/json.
+func (g *Generator) Document(info Info) *Document {
+	responses := map[string]*Response{}
+	for status, resp := range g.ErrorResponses() {
+		responses[errorResponseName(status)] = resp
+	}
+
+	return &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Components: Components{
+			Schemas:   g.schemas,
+			Responses: responses,
+		},
+	}
+}
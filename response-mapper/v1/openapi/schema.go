@@ -0,0 +1,190 @@
+package openapi
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is an OpenAPI 3.1 (JSON Schema 2020-12) schema object.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 interface{}        `json:"type,omitempty"` // a type name, or a list of names for nullable types
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Examples             []interface{}      `json:"examples,omitempty"`
+}
+
+// Ref returns a schema referencing the named component schema.
+func Ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// timeType is the reflect.Type of time.Time.
+var timeType = reflect.TypeOf(time.Time{})
+
+// jsonMarshalerType is the reflect.Type of json.Marshaler.
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// invalidNameChars matches the characters not allowed in component names.
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// schemaName returns the component name of a named type. Generic types such
+// as Page[pkg.User] become Page_User.
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	// Drop the package paths of the type arguments.
+	if i := strings.Index(name, "["); i >= 0 {
+		args := strings.Split(strings.TrimSuffix(name[i+1:], "]"), ",")
+		for j, arg := range args {
+			args[j] = arg[strings.LastIndex(arg, ".")+1:]
+		}
+		name = name[:i] + "_" + strings.Join(args, "_")
+	}
+	return strings.Trim(invalidNameChars.ReplaceAllString(name, "_"), "_")
+}
+
+// schemaOf returns the schema of a Go type. Named struct types are added to
+// the components of the generator and referenced with $ref.
+func (g *Generator) schemaOf(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return nullable(g.schemaOf(t.Elem()))
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		// Custom JSON encodings can not be described from the Go type.
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	}
+
+	// Interfaces and other kinds accept any value.
+	return &Schema{}
+}
+
+// structSchema returns the schema of a struct type, as a reference when the type is named.
+func (g *Generator) structSchema(t reflect.Type) *Schema {
+	if name, ok := g.names[t]; ok {
+		return Ref(name)
+	}
+
+	name := g.componentName(t)
+	if name == "" {
+		return g.objectSchema(t)
+	}
+
+	// Register a placeholder first, so recursive types end with a reference.
+	g.names[t] = name
+	g.schemas[name] = &Schema{}
+	*g.schemas[name] = *g.objectSchema(t)
+	return Ref(name)
+}
+
+// componentName returns a free component name for a named type. A type whose
+// name is taken by a type of another package is qualified with its package,
+// e.g. billing.User, then numbered if that is taken too.
+func (g *Generator) componentName(t reflect.Type) string {
+	name := schemaName(t)
+	if name == "" {
+		return ""
+	}
+	if _, taken := g.schemas[name]; !taken {
+		return name
+	}
+
+	name = invalidNameChars.ReplaceAllString(path.Base(t.PkgPath()), "_") + "." + name
+	candidate := name
+	for i := 2; ; i++ {
+		if _, taken := g.schemas[candidate]; !taken {
+			return candidate
+		}
+		candidate = name + "_" + strconv.Itoa(i)
+	}
+}
+
+// objectSchema describes the JSON properties of a struct type.
+func (g *Generator) objectSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := g.schemaOf(field.Type)
+		if strings.Contains(opts, "string") {
+			prop = &Schema{Type: "string"}
+		}
+		s.Properties[name] = prop
+
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			s.Required = append(s.Required, name)
+		}
+	}
+	return s
+}
+
+// nullable allows null in addition to the values of s.
+func nullable(s *Schema) *Schema {
+	switch typ := s.Type.(type) {
+	case string:
+		out := *s
+		out.Type = []string{typ, "null"}
+		return &out
+	case nil:
+		if s.Ref == "" {
+			return s
+		}
+		return &Schema{OneOf: []*Schema{s, {Type: "null"}}}
+	}
+	return s
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// CursorPagination represents the structure for cursor (keyset) paginated data.
+// Unlike Pagination it does not need a total count, so it avoids COUNT(*) queries.
+type CursorPagination struct {
+	Meta Meta
+	Data interface{}
+}
+
+// NewCursorPagination creates a CursorPagination with the given cursors.
+//
+// Parameters:
+// - data: the items of the current page.
+// - next: the cursor of the next page, empty when there is no next page.
+// - prev: the cursor of the previous page, empty when there is no previous page.
+// - hasMore: whether there are more items after the current page.
+func NewCursorPagination(data interface{}, next, prev string, hasMore bool) *CursorPagination {
+	return &CursorPagination{
+		Meta: Meta{
+			NextCursor: next,
+			PrevCursor: prev,
+			HasMore:    &hasMore,
+		},
+		Data: data,
+	}
+}
+
+// TrimCursorPage trims a page fetched with limit+1 rows to limit rows.
+// It returns the trimmed items and whether there are more items after them.
+//
+// Example:
+//
+//	rows, _ := repo.List(ctx, after, limit+1)
+//	items, hasMore := TrimCursorPage(rows, limit)
+func TrimCursorPage[T any](items []T, limit int) ([]T, bool) {
+	if limit < 0 || len(items) <= limit {
+		return items, false
+	}
+	return items[:limit], true
+}
+
+// minCursorSecretLength is the minimum length of the secret of a CursorCodec, the size of the hash.
+const minCursorSecretLength = 32
+
+// ErrWeakCursorSecret is returned by NewCursorCodec for secrets shorter than 32 bytes,
+// which would let clients forge cursors.
+var ErrWeakCursorSecret = errors.New("cursor secret must be at least 32 bytes")
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded or its signature does not match.
+func ErrInvalidCursor() *ResponseError {
+	return NewFromCatalog("PAGINATION_INVALID_CURSOR")
+}
+
+// CursorCodec encodes keyset values into opaque, signed cursors.
+//
+// A cursor is the base64 (URL-safe) encoding of the JSON keyset values followed
+// by their HMAC-SHA256 signature, so clients cannot forge or tamper with it.
+type CursorCodec struct {
+	secret []byte
+}
+
+// NewCursorCodec creates a CursorCodec that signs cursors with secret.
+// It returns ErrWeakCursorSecret if the secret is shorter than 32 bytes.
+func NewCursorCodec(secret []byte) (*CursorCodec, error) {
+	if len(secret) < minCursorSecretLength {
+		return nil, ErrWeakCursorSecret
+	}
+	return &CursorCodec{
+		secret: secret,
+	}, nil
+}
+
+// Encode encodes the keyset values, e.g. the sort column and ID of the last item, into a cursor.
+func (c *CursorCodec) Encode(values interface{}) (string, error) {
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	token := append(payload, c.sign(payload)...)
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decode verifies a cursor and decodes its keyset values into v.
+// It returns ErrInvalidCursor if the cursor is malformed or was not signed by this codec.
+// The cause is not logged, since cursors come from clients.
+func (c *CursorCodec) Decode(cursor string, v interface{}) error {
+	token, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(token) <= sha256.Size {
+		return ErrInvalidCursor().WithCauseNoLog(errors.New("malformed cursor"))
+	}
+
+	payload, signature := token[:len(token)-sha256.Size], token[len(token)-sha256.Size:]
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return ErrInvalidCursor().WithCauseNoLog(errors.New("cursor signature mismatch"))
+	}
+
+	if err := json.Unmarshal(payload, v); err != nil {
+		return ErrInvalidCursor().WithCauseNoLog(err)
+	}
+	return nil
+}
+
+// sign returns the HMAC-SHA256 signature of payload.
+func (c *CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,227 @@
+package v1
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	help "github.com/adamnasrudin03/go-helpers"
+)
+
+// Constants for page requests.
+const (
+	// DefaultPageLimit is the limit used when the request does not provide one.
+	DefaultPageLimit = 10
+	// MaxPageLimit is the highest limit accepted when PageOptions does not set one.
+	MaxPageLimit = 100
+	// MaxPageNumber is the highest page accepted when PageOptions does not set one.
+	MaxPageNumber = 100000
+
+	// SortAsc sorts in ascending order.
+	SortAsc = "asc"
+	// SortDesc sorts in descending order.
+	SortDesc = "desc"
+)
+
+// PageRequest holds the pagination parameters of a list request.
+// It can be decoded from the query string with HttpDecoder.Query, e.g.
+// ?page=2&limit=20&sort=name,created_at&direction=desc.
+type PageRequest struct {
+	Page      int    `json:"page"`
+	Limit     int    `json:"limit"`
+	Sort      string `json:"sort"`      // comma separated list of sort fields
+	Direction string `json:"direction"` // asc or desc
+}
+
+// PageOptions configures how a PageRequest is normalized.
+type PageOptions struct {
+	// DefaultLimit is used when the limit is empty or not positive. Defaults to DefaultPageLimit.
+	DefaultLimit int
+	// MaxLimit clamps the limit. Defaults to MaxPageLimit.
+	MaxLimit int
+	// MaxPage clamps the page, so the offset can not overflow. Defaults to MaxPageNumber.
+	MaxPage int
+	// AllowedSorts is the allow-list of sort fields. Any sort field is rejected when it is empty.
+	AllowedSorts []string
+	// DefaultSort is used when the request does not provide sort fields.
+	DefaultSort string
+	// DefaultDirection is used when the request does not provide a direction. Defaults to SortAsc.
+	DefaultDirection string
+}
+
+// ParsePageRequest decodes the pagination parameters from the query string of r
+// and normalizes them with opt.
+func ParsePageRequest(r *http.Request, opt PageOptions) (PageRequest, error) {
+	var req PageRequest
+	if err := help.NewHttpDecoder().Query(r, &req); err != nil {
+		return req, ErrGetRequest().WithCauseNoLog(err)
+	}
+
+	if err := req.Normalize(opt); err != nil {
+		return req, err
+	}
+	return req, nil
+}
+
+// Normalize applies the defaults of opt, clamps the page and limit and checks
+// the sort fields and direction against the allow-list.
+// It returns an ErrValidation error when the sort fields or direction are not allowed.
+func (p *PageRequest) Normalize(opt PageOptions) error {
+	if opt.DefaultLimit <= 0 {
+		opt.DefaultLimit = DefaultPageLimit
+	}
+	if opt.MaxLimit <= 0 {
+		opt.MaxLimit = MaxPageLimit
+	}
+	if opt.MaxPage <= 0 {
+		opt.MaxPage = MaxPageNumber
+	}
+	if opt.DefaultDirection == "" {
+		opt.DefaultDirection = SortAsc
+	}
+
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Page > opt.MaxPage {
+		p.Page = opt.MaxPage
+	}
+	if p.Limit <= 0 {
+		p.Limit = opt.DefaultLimit
+	}
+	if p.Limit > opt.MaxLimit {
+		p.Limit = opt.MaxLimit
+	}
+
+	p.Sort = strings.TrimSpace(p.Sort)
+	if p.Sort == "" {
+		p.Sort = opt.DefaultSort
+	}
+	for _, field := range p.SortFields() {
+		if !isAllowedSort(field, opt.AllowedSorts) {
+			return ErrInvalid(fmt.Sprintf("Urutan %s", field), fmt.Sprintf("sort %s", field))
+		}
+	}
+
+	p.Direction = strings.ToLower(strings.TrimSpace(p.Direction))
+	if p.Direction == "" {
+		p.Direction = opt.DefaultDirection
+	}
+	if p.Direction != SortAsc && p.Direction != SortDesc {
+		return ErrInvalid(fmt.Sprintf("Arah urutan %s", p.Direction), fmt.Sprintf("sort direction %s", p.Direction))
+	}
+
+	return nil
+}
+
+// Offset returns the number of records to skip for the current page.
+// It returns math.MaxInt instead of overflowing for a page that was not clamped by Normalize.
+func (p PageRequest) Offset() int {
+	if p.Page < 1 || p.Limit <= 0 {
+		return 0
+	}
+	if p.Page-1 > math.MaxInt/p.Limit {
+		return math.MaxInt
+	}
+	return (p.Page - 1) * p.Limit
+}
+
+// SortFields returns the sort fields as a slice.
+func (p PageRequest) SortFields() []string {
+	var fields []string
+	for _, field := range strings.Split(p.Sort, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// OrderBy returns the sort fields and direction as an SQL ORDER BY expression,
+// e.g. "name DESC, created_at DESC". It is only safe to use after Normalize
+// has checked the fields against the allow-list.
+func (p PageRequest) OrderBy() string {
+	fields := p.SortFields()
+	for i, field := range fields {
+		fields[i] = field + " " + strings.ToUpper(p.Direction)
+	}
+	return strings.Join(fields, ", ")
+}
+
+// isAllowedSort checks if field is in the allow-list.
+func isAllowedSort(field string, allowed []string) bool {
+	for _, v := range allowed {
+		if v == field {
+			return true
+		}
+	}
+	return false
+}
+
+// NewPagination creates a Pagination from the page data and the total number of
+// records, filling every Meta field.
+func NewPagination(data interface{}, totalRecords int, req PageRequest) *Pagination {
+	return &Pagination{
+		Meta: Meta{
+			Page:         req.Page,
+			Limit:        req.Limit,
+			TotalRecords: totalRecords,
+			TotalPages:   totalPages(totalRecords, req.Limit),
+		},
+		Data: data,
+	}
+}
+
+// totalPages computes the number of pages needed for totalRecords.
+func totalPages(totalRecords, limit int) int {
+	if limit <= 0 || totalRecords <= 0 {
+		return 0
+	}
+	return (totalRecords + limit - 1) / limit
+}
+
+// WithLinks builds the RFC 8288 Link header of the pagination from the request
+// URL u, which RenderJSON then writes with the response.
+func (p *Pagination) WithLinks(u *url.URL) *Pagination {
+	p.Link = p.LinkHeader(u)
+	return p
+}
+
+// LinkHeader returns the RFC 8288 Link header value with the first, prev, next
+// and last pages of the pagination, keeping the other query parameters of u.
+func (p *Pagination) LinkHeader(u *url.URL) string {
+	if u == nil || p.Meta.Limit <= 0 {
+		return ""
+	}
+
+	lastPage := p.Meta.TotalPages
+	if lastPage == 0 {
+		lastPage = totalPages(p.Meta.TotalRecords, p.Meta.Limit)
+	}
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	link := func(page int, rel string) string {
+		ref := *u
+		query := ref.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(p.Meta.Limit))
+		ref.RawQuery = query.Encode()
+		return fmt.Sprintf(`<%s>; rel="%s"`, ref.String(), rel)
+	}
+
+	links := []string{link(1, "first")}
+	if p.Meta.Page > 1 {
+		links = append(links, link(p.Meta.Page-1, "prev"))
+	}
+	if p.Meta.Page < lastPage {
+		links = append(links, link(p.Meta.Page+1, "next"))
+	}
+	links = append(links, link(lastPage, "last"))
+
+	return strings.Join(links, ", ")
+}
@@ -58,10 +58,14 @@ func StatusMapping(statusCode int) string {
 //
 // It takes an error as input and returns an error.
 // The function first checks if the error is of type validator.ValidationErrors.
-// If it is, it formats the error messages and stores them in msgEnUs.
-// The function then translates the error messages from English to Indonesian and stores the translated messages in msgIdn.
-// If there is an error during translation, the function logs the error and sets msgIdn equal to msgEnUs.
-// Finally, the function returns a new error of type *ResponseError with the translated error messages.
+// If it is, it formats the error message of every field in English, and in
+// Indonesian with the messages registered in validators, which cover the
+// go-playground built-in tags (see validators.RegisterMessageLang). The English
+// messages of the other fields, e.g. custom tags without an Indonesian message,
+// are translated to Indonesian in a single call. If there is an error during
+// translation, the function logs the error and keeps the English messages.
+// Finally, the function returns a new error of type *ResponseError with the
+// joined messages and the details of every field in Errors.
 func FormatValidationError(err error) error {
 	var (
 		msgIdn  string // Holds the translated error messages
@@ -69,38 +73,95 @@ func FormatValidationError(err error) error {
 	)
 
 	// Check if the error is of type validator.ValidationErrors.
-	if errValidate := err.(validator.ValidationErrors); errValidate != nil {
-		// Format the error messages and store them in msgEnUs.
-		msgEnUs = formatMessageValidator(errValidate)
-		msgEnUs = strings.TrimSpace(msgEnUs) + "."
-	}
+	errValidate, _ := err.(validator.ValidationErrors)
 
-	// Translate the error messages from English to Indonesian and store the translated messages in msgIdn.
-	msgIdn, errTranslate := help.Translate(help.Auto, help.LangID, msgEnUs)
-	if errTranslate != nil {
-		// If there is an error during translation, log the error and set msgIdn equal to msgEnUs.
-		msgIdn = msgEnUs
-		log.Printf("Translate Text %v to %v error: %v \n", help.Auto, help.LangID, errTranslate)
+	// Format the error message of every field in English and Indonesian.
+	details := formatDetailsValidator(errValidate)
+	if len(details) > 0 {
+		idn := make([]string, len(details))
+		enUs := make([]string, len(details))
+		for i, d := range details {
+			idn[i] = d.Message.ID
+			enUs[i] = d.Message.EN
+		}
+		msgIdn = strings.Join(idn, ", ") + "."
+		msgEnUs = strings.Join(enUs, ", ") + "."
 	}
 
 	// Return a new error of type *ResponseError with the translated error messages.
-	return NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
+	respErr := NewError(ErrValidation, NewResponseMultiLang(MultiLanguages{
 		ID: msgIdn,
 		EN: msgEnUs,
 	}))
+	respErr.ErrorCode = "VALIDATION_FAILED"
+	respErr.Errors = details
+	return respErr
 }
 
-// formatMessageValidator formats multiple validation error messages.
+// ValidateFields runs the rules of validators.Field and returns their failures
+// formatted by FormatValidationError, or nil when every rule passes.
 //
-// It takes a slice of validator.ValidationErrors and returns a single formatted string.
-func formatMessageValidator(errs validator.ValidationErrors) string {
-	var msgEnUs strings.Builder
-	msgEnUs.Grow(len(errs) * 20) // rough estimate of the length of the joined string
+//	if err := response_mapper.ValidateFields(
+//		validators.Field("email", form["email"]).Required().Email().MaxLen(100),
+//	); err != nil {
+//		response_mapper.RenderJSON(w, http.StatusBadRequest, err)
+//	}
+func ValidateFields(fields ...*validators.FieldRule) error {
+	if err := validators.Check(fields...); err != nil {
+		return FormatValidationError(err)
+	}
+	return nil
+}
+
+// formatDetailsValidator formats the English and Indonesian message of every validation error.
+func formatDetailsValidator(errs validator.ValidationErrors) []ErrorDetail {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	details := make([]ErrorDetail, len(errs))
+	var untranslated []int // indexes of the details without a registered Indonesian message
 	for i, e := range errs {
-		if i > 0 {
-			msgEnUs.WriteString(", ")
+		details[i] = ErrorDetail{
+			Field: validators.FieldPath(e),
+			Tag:   e.Tag(),
+			Param: e.Param(),
+			Message: MultiLanguages{
+				EN: validators.FormatErrorValidatorSingle(e),
+			},
+		}
+
+		if msg, ok := validators.LookupMessage(validators.LangID, e); ok {
+			details[i].Message.ID = msg
+		} else {
+			details[i].Message.ID = details[i].Message.EN
+			untranslated = append(untranslated, i)
 		}
-		msgEnUs.WriteString(validators.FormatErrorValidatorSingle(e))
 	}
-	return msgEnUs.String()
+
+	if len(untranslated) == 0 {
+		return details
+	}
+
+	// Translate the English messages from English to Indonesian, one message per line.
+	lines := make([]string, len(untranslated))
+	for i, idx := range untranslated {
+		lines[i] = details[idx].Message.EN
+	}
+	translated, errTranslate := help.Translate(strings.Join(lines, "\n"), help.Auto, help.LangID)
+	if errTranslate != nil {
+		// If there is an error during translation, log the error and keep the English messages.
+		log.Printf("Translate Text %v to %v error: %v \n", help.Auto, help.LangID, errTranslate)
+		return details
+	}
+
+	translatedLines := strings.Split(strings.TrimSpace(translated), "\n")
+	if len(translatedLines) != len(untranslated) {
+		log.Printf("Translate Text %v to %v error: got %d lines, want %d \n", help.Auto, help.LangID, len(translatedLines), len(untranslated))
+		return details
+	}
+	for i, idx := range untranslated {
+		details[idx].Message.ID = strings.TrimSpace(translatedLines[i])
+	}
+	return details
 }
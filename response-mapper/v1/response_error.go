@@ -1,14 +1,31 @@
 package v1
 
 import (
+	"context"
+	"log"
 	"net/http"
+
+	help "github.com/adamnasrudin03/go-helpers"
 )
 
 // ResponseError is used to represent an error response to the client.
 type ResponseError struct {
-	Status  string         `json:"status"`
-	Code    int            `json:"code"`
-	Err     error          `json:"-"`
+	Status     string         `json:"status"`
+	Code       int            `json:"code"`
+	ErrorCode  string         `json:"error_code,omitempty"`
+	HttpStatus int            `json:"-"`
+	Err        error          `json:"-"`
+	Cause      error          `json:"-"`
+	Message    MultiLanguages `json:"message"`
+	Errors     []ErrorDetail  `json:"errors,omitempty"`
+	RequestID  string         `json:"request_id,omitempty"`
+}
+
+// ErrorDetail describes the error of a single field, e.g. a failed validation.
+type ErrorDetail struct {
+	Field   string         `json:"field"`           // Field is the path of the field, e.g. items[2].qty.
+	Tag     string         `json:"tag,omitempty"`   // Tag is the failed validation tag, e.g. required.
+	Param   string         `json:"param,omitempty"` // Param is the parameter of the tag, e.g. 100 for max=100.
 	Message MultiLanguages `json:"message"`
 }
 
@@ -16,9 +33,13 @@ type ResponseError struct {
 //
 // It sets the status, code, and message of the error based on the error code.
 // If the error is already a MultiLanguages, it uses the error's message.
-// If the error is not a MultiLanguages, it sets the ID and EN message to the error's message.
+// If the error is not a MultiLanguages, it sets the ID and EN message to the error's message
+// and keeps the error as the cause, so errors.Is and errors.As still match it.
 func NewError(code TypeError, err error) *ResponseError {
-	var respErr MultiLanguages
+	var (
+		respErr MultiLanguages
+		cause   error
+	)
 	if errValue, isMatch := err.(*MultiLanguages); isMatch {
 		if errValue != nil {
 			respErr = *errValue
@@ -33,20 +54,92 @@ func NewError(code TypeError, err error) *ResponseError {
 			ID: err.Error(),
 			EN: err.Error(),
 		}
+		cause = err
 	}
 	return &ResponseError{
 		Status:  StatusMapping(int(code)),
 		Code:    int(code),
 		Err:     err,
+		Cause:   cause,
 		Message: respErr,
 	}
 }
 
+// Wrap creates a new ResponseError that wraps cause.
+//
+// The cause is logged for internal troubleshooting and stays reachable through
+// errors.Is and errors.As, while the client only receives the safe message msg.
+func Wrap(cause error, code TypeError, msg MultiLanguages) *ResponseError {
+	return NewError(code, NewResponseMultiLang(msg)).WithCause(cause)
+}
+
+// WithCause sets the underlying cause of the error and logs it.
+// The cause is never rendered to the client.
+func (e *ResponseError) WithCause(cause error) *ResponseError {
+	if cause != nil {
+		log.Printf("response_mapper_v1 %v (%v): %v \n", e.Message.EN, TypeError(e.Code), cause)
+	}
+	e.Cause = cause
+	return e
+}
+
+// WithCauseNoLog sets the underlying cause of the error without logging it,
+// for errors caused by client input that clients could use to flood the logs.
+// The cause is never rendered to the client.
+func (e *ResponseError) WithCauseNoLog(cause error) *ResponseError {
+	e.Cause = cause
+	return e
+}
+
+// WithRequestID sets the request ID stored in ctx, see help.RequestIDFromContext.
+// RenderJSON already fills it from the X-Request-ID response header when it is empty.
+func (e *ResponseError) WithRequestID(ctx context.Context) *ResponseError {
+	e.RequestID = help.RequestIDFromContext(ctx)
+	return e
+}
+
 // Error returns the string representation of the error.
 func (e *ResponseError) Error() string {
+	if e.Err == nil {
+		return e.Message.Error()
+	}
 	return e.Err.Error()
 }
 
+// Unwrap returns the underlying cause of the error.
+func (e *ResponseError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether the error matches target.
+//
+// A TypeError target matches when the error has the same code, e.g.
+// errors.Is(err, ErrNoFound). A *ResponseError target matches on the same
+// code and, when the target has one, the same error code, e.g.
+// errors.Is(err, ErrNotFound()).
+func (e *ResponseError) Is(target error) bool {
+	switch t := target.(type) {
+	case TypeError:
+		return e.Code == int(t)
+	case *ResponseError:
+		if t == nil || e.Code != t.Code {
+			return false
+		}
+		return t.ErrorCode == "" || e.ErrorCode == t.ErrorCode
+	}
+	return false
+}
+
+// StatusCode returns the HTTP status code used to render the error.
+// It returns HttpStatus when the error definition overrides it, otherwise the
+// status mapped from the error code.
+func (e *ResponseError) StatusCode() int {
+	if e.HttpStatus != 0 {
+		return e.HttpStatus
+	}
+	return StatusErrorMapping(e.Code)
+}
+
 // statusErrorMapping maps error codes to HTTP status codes.
 var statusErrorMapping = map[int]int{
 	int(ErrForbidden):    http.StatusForbidden,
@@ -57,6 +150,9 @@ var statusErrorMapping = map[int]int{
 	int(ErrValidation):   http.StatusBadRequest,
 	int(ErrNoFound):      http.StatusNotFound,
 	int(ErrUnknown):      http.StatusInternalServerError,
+	int(ErrTimeout):      http.StatusGatewayTimeout,
+	int(ErrNotAllowed):   http.StatusMethodNotAllowed,
+	int(ErrRateLimit):    http.StatusTooManyRequests,
 }
 
 // StatusErrorMapping returns the HTTP status code for the given error code.
@@ -0,0 +1,363 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	help "github.com/adamnasrudin03/go-helpers"
+)
+
+// Constants for exported files.
+const (
+	// ExportCSV is the export format of CSV files.
+	ExportCSV = "csv"
+	// ExportXLSX is the export format of Excel files.
+	ExportXLSX = "xlsx"
+
+	// ContentTypeCSV is the content type of CSV files.
+	ContentTypeCSV = "text/csv"
+	// ContentTypeXLSX is the content type of Excel files.
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// exportTag is the struct tag describing the exported columns.
+	exportTag = "export"
+	// jsonTag is the struct tag used for the column headers of untagged fields.
+	jsonTag = "json"
+)
+
+// exportLayouts maps the layout names usable in the export tag to the format constants of help.
+var exportLayouts = map[string]string{
+	"FormatDateTime":            help.FormatDateTime,
+	"FormatDateHourMinutes":     help.FormatDateHourMinutes,
+	"FormatDate":                help.FormatDate,
+	"FormatDateDDMMYYYY":        help.FormatDateDDMMYYYY,
+	"FormatLocalTime":           help.FormatLocalTime,
+	"FormatLocalTimeDDsMMsYYYY": help.FormatLocalTimeDDsMMsYYYY,
+	"FormatDateConcise":         help.FormatDateConcise,
+	"FormatDateMonthYear":       help.FormatDateMonthYear,
+	"FormatDateMonth":           help.FormatDateMonth,
+	"FormatTimeMinute":          help.FormatTimeMinute,
+	"FormatTimeMinuteSecond":    help.FormatTimeMinuteSecond,
+}
+
+// timeType is the reflect.Type of time.Time.
+var timeType = reflect.TypeOf(time.Time{})
+
+// exportColumn describes a single column of an exported file.
+type exportColumn struct {
+	header string
+	index  []int
+	order  int
+	layout string
+}
+
+// exportCell is a single formatted cell of an exported file.
+type exportCell struct {
+	value  string
+	number bool
+}
+
+// ExportFormat returns the export format requested by r, or an empty string
+// when a JSON response is expected.
+//
+// The "format" query parameter takes precedence over the Accept header, e.g.
+// ?format=xlsx or Accept: text/csv.
+func ExportFormat(r *http.Request) string {
+	switch strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format"))) {
+	case ExportCSV:
+		return ExportCSV
+	case ExportXLSX:
+		return ExportXLSX
+	}
+
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case ContentTypeCSV:
+			return ExportCSV
+		case ContentTypeXLSX:
+			return ExportXLSX
+		}
+	}
+	return ""
+}
+
+// RenderExport renders the data as a CSV or Excel file when the request asks
+// for one (see ExportFormat), and as JSON with RenderJSON otherwise.
+//
+// The data is a *Pagination, a *CursorPagination, or a slice of structs. The
+// columns come from the `export` struct tag: `export:"Full Name,order=1"` sets
+// the header and order of the column, `layout=FormatDate` sets the format of
+// a time value (a help format constant name or a time layout, defaults to
+// FormatDateTime; it is the last option, so a layout may contain commas) and
+// `export:"-"` skips the field. NaN and infinite floats are written as empty cells. When no field has an
+// export tag, every exported field is used with its json name. Time values
+// are written with Indonesian month names.
+//
+// Parameters:
+// - w: the response writer.
+// - r: the request, read for the export format.
+// - filename: the name of the downloaded file, without extension.
+// - v: the response data.
+func RenderExport(w http.ResponseWriter, r *http.Request, filename string, v interface{}) {
+	format := ExportFormat(r)
+	if format == "" {
+		RenderJSON(w, http.StatusOK, v)
+		return
+	}
+
+	data := v
+	switch p := v.(type) {
+	case *Pagination:
+		data = p.Data
+	case *CursorPagination:
+		data = p.Data
+	}
+
+	var (
+		buf         bytes.Buffer
+		err         error
+		contentType string
+	)
+	switch format {
+	case ExportXLSX:
+		contentType = ContentTypeXLSX
+		err = WriteXLSX(&buf, data)
+	default:
+		contentType = ContentTypeCSV + "; charset=utf-8"
+		err = WriteCSV(&buf, data)
+	}
+	if err != nil {
+		RenderJSON(w, http.StatusInternalServerError, ErrInternalServerError().WithCause(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": filename + "." + format,
+	}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// WriteCSV writes a slice of structs as a CSV file, with the columns described by the export tag.
+func WriteCSV(w io.Writer, data interface{}) error {
+	header, rows, err := exportTable(data)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			record[i] = cell.value
+			if !cell.number {
+				record[i] = escapeCSVFormula(cell.value)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteXLSX writes a slice of structs as an Excel file, with the columns described by the export tag.
+func WriteXLSX(w io.Writer, data interface{}) error {
+	header, rows, err := exportTable(data)
+	if err != nil {
+		return err
+	}
+	return writeXLSX(w, header, rows)
+}
+
+// escapeCSVFormula prefixes text cells that spreadsheet applications would run as a formula.
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// exportTable converts a slice of structs into a header and rows of formatted cells.
+func exportTable(data interface{}) ([]string, [][]exportCell, error) {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("export: data must be a slice, got %v", v.Kind())
+	}
+
+	elemType := v.Type().Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	// Slices of non-struct values are written as a single column.
+	if elemType.Kind() != reflect.Struct || elemType == timeType {
+		rows := make([][]exportCell, v.Len())
+		for i := range rows {
+			rows[i] = []exportCell{exportValue(v.Index(i), help.FormatDateTime)}
+		}
+		return []string{"value"}, rows, nil
+	}
+
+	columns := exportColumns(elemType)
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.header
+	}
+
+	rows := make([][]exportCell, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		for item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				break
+			}
+			item = item.Elem()
+		}
+
+		row := make([]exportCell, len(columns))
+		if item.Kind() == reflect.Struct {
+			for j, col := range columns {
+				field, err := item.FieldByIndexErr(col.index)
+				if err == nil {
+					row[j] = exportValue(field, col.layout)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows, nil
+}
+
+// exportColumns returns the exported columns of a struct type, in order.
+func exportColumns(t reflect.Type) []exportColumn {
+	var (
+		tagged   []exportColumn
+		untagged []exportColumn
+	)
+
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		tag, hasTag := field.Tag.Lookup(exportTag)
+		if tag == "-" {
+			continue
+		}
+
+		col := exportColumn{
+			header: field.Name,
+			index:  field.Index,
+			order:  len(tagged) + len(untagged),
+			layout: help.FormatDateTime,
+		}
+		if name := strings.Split(field.Tag.Get(jsonTag), ",")[0]; name != "" && name != "-" {
+			col.header = name
+		}
+
+		if !hasTag {
+			untagged = append(untagged, col)
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		if name := strings.TrimSpace(parts[0]); name != "" {
+			col.header = name
+		}
+	options:
+		for i, option := range parts[1:] {
+			key, value, _ := strings.Cut(option, "=")
+			switch strings.TrimSpace(key) {
+			case "order":
+				if order, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+					col.order = order
+				}
+			case "layout":
+				// The layout is the last option, so it may contain commas, e.g. "Jan 2, 2006".
+				value = strings.Join(append([]string{value}, parts[i+2:]...), ",")
+				col.layout = value
+				if layout, ok := exportLayouts[strings.TrimSpace(value)]; ok {
+					col.layout = layout
+				}
+				break options
+			}
+		}
+		tagged = append(tagged, col)
+	}
+
+	// Only the tagged fields are exported when at least one field has an export tag.
+	columns := untagged
+	if len(tagged) > 0 {
+		columns = tagged
+	}
+	sort.SliceStable(columns, func(i, j int) bool {
+		return columns[i].order < columns[j].order
+	})
+	return columns
+}
+
+// exportValue formats a single value into a cell.
+func exportValue(v reflect.Value, layout string) exportCell {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return exportCell{}
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return exportCell{}
+		}
+		return exportCell{value: help.FormatTimeID(t, layout)}
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return exportCell{value: strconv.FormatInt(v.Int(), 10), number: true}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return exportCell{value: strconv.FormatUint(v.Uint(), 10), number: true}
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			// NaN and infinities are not valid numeric cells, leave them empty.
+			return exportCell{}
+		}
+		return exportCell{value: strconv.FormatFloat(f, 'f', -1, v.Type().Bits()), number: true}
+	case reflect.String:
+		return exportCell{value: v.String()}
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return exportCell{value: s.String()}
+	}
+	return exportCell{value: fmt.Sprint(v.Interface())}
+}
@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ResponseMessage is the message of a response envelope, which is either a
+// plain string or a MultiLanguages object.
+type ResponseMessage struct {
+	Text      string          // Text is the message when it is a plain string.
+	Languages *MultiLanguages // Languages is the message when it is a MultiLanguages object.
+}
+
+// String returns the message, in English when it is a MultiLanguages object.
+func (m ResponseMessage) String() string {
+	if m.Languages != nil {
+		return m.Languages.EN
+	}
+	return m.Text
+}
+
+// MarshalJSON encodes the message as a string or a MultiLanguages object.
+func (m ResponseMessage) MarshalJSON() ([]byte, error) {
+	if m.Languages != nil {
+		return json.Marshal(m.Languages)
+	}
+	return json.Marshal(m.Text)
+}
+
+// UnmarshalJSON decodes a message that is a string or a MultiLanguages object.
+func (m *ResponseMessage) UnmarshalJSON(d []byte) error {
+	d = bytes.TrimSpace(d)
+	if len(d) > 0 && d[0] == '{' {
+		m.Text = ""
+		m.Languages = &MultiLanguages{}
+		return json.Unmarshal(d, m.Languages)
+	}
+
+	m.Languages = nil
+	return json.Unmarshal(d, &m.Text)
+}
+
+// Response is the typed form of ResponseDefault for a single data value,
+// e.g. Response[User] for the body rendered by RenderJSON(w, http.StatusOK, user).
+type Response[T any] struct {
+	Status  string           `json:"status"`
+	Message *ResponseMessage `json:"message,omitempty"`
+	Meta    *Meta            `json:"meta,omitempty"`
+	Data    T                `json:"data,omitempty"`
+}
+
+// PagedResponse is the typed form of ResponseDefault for a *Pagination or a *CursorPagination of T.
+type PagedResponse[T any] struct {
+	Status  string           `json:"status"`
+	Message *ResponseMessage `json:"message,omitempty"`
+	Meta    Meta             `json:"meta"`
+	Data    []T              `json:"data"`
+}
+
+// DecodeResponse parses a response envelope with a single data value.
+//
+// Successful status codes (2xx) are decoded into a Response[T]. Other status
+// codes return the error envelope as a *ResponseError that keeps the remote
+// status code, or ErrRemoteResponseInvalid when the body is not an error
+// envelope, so it can be returned or rendered as is.
+//
+// Parameters:
+// - statusCode: the HTTP status code of the remote response.
+// - body: the body of the remote response.
+func DecodeResponse[T any](statusCode int, body []byte) (*Response[T], error) {
+	resp := &Response[T]{}
+	if err := decodeEnvelope(statusCode, body, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DecodePagedResponse parses a paginated response envelope, see DecodeResponse.
+func DecodePagedResponse[T any](statusCode int, body []byte) (*PagedResponse[T], error) {
+	resp := &PagedResponse[T]{}
+	if err := decodeEnvelope(statusCode, body, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReadResponse reads and closes the body of an HTTP response and parses it with DecodeResponse.
+func ReadResponse[T any](resp *http.Response) (*Response[T], error) {
+	body, err := readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeResponse[T](resp.StatusCode, body)
+}
+
+// ReadPagedResponse reads and closes the body of an HTTP response and parses it with DecodePagedResponse.
+func ReadPagedResponse[T any](resp *http.Response) (*PagedResponse[T], error) {
+	body, err := readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	return DecodePagedResponse[T](resp.StatusCode, body)
+}
+
+// readBody reads and closes the body of an HTTP response.
+func readBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// decodeEnvelope decodes a success envelope into v, or returns the error envelope as a *ResponseError.
+func decodeEnvelope(statusCode int, body []byte, v interface{}) error {
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return DecodeError(statusCode, body)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return ErrRemoteResponseInvalid(statusCode).WithCause(fmt.Errorf("decode response: %w", err))
+	}
+	return nil
+}
+
+// DecodeError parses an error envelope into a *ResponseError that keeps the
+// remote status code. It returns ErrRemoteResponseInvalid when the body is not
+// an error envelope.
+func DecodeError(statusCode int, body []byte) *ResponseError {
+	var respErr ResponseError
+	if err := json.Unmarshal(body, &respErr); err != nil || respErr.Code == 0 {
+		if err == nil {
+			err = fmt.Errorf("response has no error code")
+		}
+		return ErrRemoteResponseInvalid(statusCode).WithCause(fmt.Errorf("decode error response: %w", err))
+	}
+
+	respErr.HttpStatus = statusCode
+	return &respErr
+}
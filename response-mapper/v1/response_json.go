@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// OptionJSON is a function type used for applying options to WriteJSON and RenderJSON.
+type OptionJSON func(*jsonConfig)
+
+// jsonConfig holds the configuration of a JSON response.
+type jsonConfig struct {
+	prefix     string          // prefix is the prefix of every indented line.
+	indent     string          // indent is the indentation of pretty-printed responses, empty for compact ones.
+	jsonpSafe  bool            // jsonpSafe escapes the characters that are unsafe in a script or JSONP callback.
+	etagSource *http.Request   // etagSource is the request checked for If-None-Match, nil when ETags are disabled.
+	ctx        context.Context // ctx is the request context, read for the request ID.
+}
+
+// WithPrettyPrint indents the response with two spaces.
+func WithPrettyPrint() OptionJSON {
+	return WithIndent("", "  ")
+}
+
+// WithIndent indents the response like json.MarshalIndent.
+func WithIndent(prefix, indent string) OptionJSON {
+	return func(c *jsonConfig) {
+		c.prefix = prefix
+		c.indent = indent
+	}
+}
+
+// WithJSONPSafe escapes ' and / in addition to the <, >, & and line separators
+// escaped by encoding/json, so the response can be embedded in an HTML script
+// or wrapped in a JSONP callback.
+func WithJSONPSafe() OptionJSON {
+	return func(c *jsonConfig) {
+		c.jsonpSafe = true
+	}
+}
+
+// WithETag sets the ETag header of successful GET and HEAD responses, and
+// answers 304 Not Modified without a body when the If-None-Match header of r
+// matches it.
+func WithETag(r *http.Request) OptionJSON {
+	return func(c *jsonConfig) {
+		c.etagSource = r
+	}
+}
+
+// WithRequestContext reads the request ID of error responses from ctx (see
+// help.RequestIDFromContext), as stored by the RequestID middleware whatever
+// its header. Without it the request ID is read from the response header.
+func WithRequestContext(ctx context.Context) OptionJSON {
+	return func(c *jsonConfig) {
+		c.ctx = ctx
+	}
+}
+
+// newJSONConfig creates a jsonConfig with the given options.
+func newJSONConfig(options ...OptionJSON) *jsonConfig {
+	cfg := &jsonConfig{ctx: context.Background()}
+	// Apply any passed options to the response.
+	for _, o := range options {
+		o(cfg)
+	}
+	return cfg
+}
+
+// marshal encodes v with the configured indentation and escaping.
+func (c *jsonConfig) marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent(c.prefix, c.indent)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	// Encode appends a newline, which Marshal does not.
+	d := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	if c.jsonpSafe {
+		d = escapeJSONP(d)
+	}
+	return d, nil
+}
+
+// escapeJSONP escapes ' and / of an encoded JSON value. Both can only appear
+// inside strings, where ' and \/ are valid escapes.
+func escapeJSONP(d []byte) []byte {
+	if !bytes.ContainsAny(d, `'/`) {
+		return d
+	}
+
+	out := make([]byte, 0, len(d)+16)
+	for _, b := range d {
+		switch b {
+		case '\'':
+			out = append(out, `\u0027`...)
+		case '/':
+			out = append(out, `\/`...)
+		default:
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// etag returns the strong entity tag of a response body.
+func etag(d []byte) string {
+	sum := sha256.Sum256(d)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether the If-None-Match header value matches the
+// entity tag, with the weak comparison of RFC 9110.
+func etagMatches(ifNoneMatch, tag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
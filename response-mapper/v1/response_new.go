@@ -1,7 +1,8 @@
 package v1
 
 import (
-	"encoding/json"
+	"context"
+	"log"
 	"net/http"
 
 	help "github.com/adamnasrudin03/go-helpers"
@@ -10,30 +11,98 @@ import (
 // WriteJSON writes the JSON representation of v to the response writer w,
 // and sets the status code of the response to statusCode. It returns an error
 // if there was an error during the operation.
-func WriteJSON(w http.ResponseWriter, statusCode int, v interface{}) error {
-	defer help.PanicRecover("response_mapper_v1-WriteJSON")
+//
+// The data is marshaled before any header is written, so when v cannot be
+// marshaled the client receives a 500 ErrInternalServerError response instead
+// of a success status with an empty body, and the marshal error is returned.
+// A panicking MarshalJSON is handled the same way, returning a *help.PanicError.
+func WriteJSON(w http.ResponseWriter, statusCode int, v interface{}, options ...OptionJSON) (err error) {
+	defer help.PanicRecoverError("response_mapper_v1-WriteJSON", &err)
 
-	// Set the Content-Type header to application/json
-	w.Header().Set("Content-Type", "application/json")
-
-	// Write the status code to the response header
-	w.WriteHeader(statusCode)
+	cfg := newJSONConfig(options...)
 
 	// Marshal the data to JSON format
-	d, err := json.Marshal(v)
+	d, err := marshalJSON(cfg, v)
 	if err != nil {
+		log.Printf("response_mapper_v1-WriteJSON marshal error: %v \n", err)
+		statusCode, resp := PrepareResponseContext(cfg.ctx, w.Header(), http.StatusInternalServerError, ErrInternalServerError())
+		d, _ = cfg.marshal(resp)
+		if writeErr := writeJSONBody(w, statusCode, d, cfg); writeErr != nil {
+			return writeErr
+		}
 		return err
 	}
 
+	return writeJSONBody(w, statusCode, d, cfg)
+}
+
+// marshalJSON marshals v with the options of cfg, converting a panic of a
+// MarshalJSON method into an error so WriteJSON can answer with a 500.
+func marshalJSON(cfg *jsonConfig, v interface{}) (d []byte, err error) {
+	defer help.PanicRecoverError("response_mapper_v1-WriteJSON", &err)
+	return cfg.marshal(v)
+}
+
+// writeJSONBody writes the headers, the status code and the encoded body of a JSON response.
+func writeJSONBody(w http.ResponseWriter, statusCode int, d []byte, cfg *jsonConfig) error {
+	// Set the Content-Type header to application/json
+	w.Header().Set("Content-Type", "application/json")
+
+	// Answer conditional requests of unchanged responses with 304 Not Modified
+	if r := cfg.etagSource; r != nil && statusCode == http.StatusOK &&
+		(r.Method == http.MethodGet || r.Method == http.MethodHead) {
+		tag := etag(d)
+		w.Header().Set("ETag", tag)
+		if etagMatches(r.Header.Get("If-None-Match"), tag) {
+			w.Header().Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
+	// Write the status code to the response header
+	w.WriteHeader(statusCode)
+
 	// Write the JSON data to the response writer
-	_, err = w.Write(d)
+	_, err := w.Write(d)
 	return err
 }
 
 // RenderJSON renders the response based on the provided data.
 // It writes the response data in JSON format with the specified status code.
 // If the input data is an error, it sets the status code according to the error code.
-func RenderJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+//
+// Pass WithRequestContext(r.Context()) to render the request ID stored by the
+// RequestID middleware in error responses.
+func RenderJSON(w http.ResponseWriter, statusCode int, v interface{}, options ...OptionJSON) {
+	// Create the response structure and resolve the status code based on the input data
+	statusCode, resp := PrepareResponseContext(newJSONConfig(options...).ctx, w.Header(), statusCode, v)
+
+	// Write the response data in JSON format with the specified status code
+	_ = WriteJSON(w, statusCode, resp, options...)
+}
+
+// PrepareResponse resolves the status code and the response structure that
+// RenderJSON writes for the provided data, and sets the response headers.
+// The request ID is only read from the response header, see PrepareResponseContext.
+func PrepareResponse(header http.Header, statusCode int, v interface{}) (int, interface{}) {
+	return PrepareResponseContext(context.Background(), header, statusCode, v)
+}
+
+// PrepareResponseContext resolves the status code and the response structure
+// that RenderJSON writes for the provided data, and sets the response headers.
+// It lets framework adapters render the same envelope as RenderJSON.
+//
+// Parameters:
+// - ctx: the request context, read for the request ID (see RequestID).
+// - header: the response headers, read for the request ID and written with the pagination Link.
+// - statusCode: the requested HTTP status code.
+// - v: the response data.
+//
+// Returns:
+// - int: the HTTP status code to write, taken from the error when v is a *ResponseError.
+// - interface{}: the response structure to encode.
+func PrepareResponseContext(ctx context.Context, header http.Header, statusCode int, v interface{}) (int, interface{}) {
 	// Create the response structure based on the input data
 	resp := RenderStruct(statusCode, v)
 
@@ -41,12 +110,42 @@ func RenderJSON(w http.ResponseWriter, statusCode int, v interface{}) {
 	if val, isErr := v.(error); isErr {
 		// If the input data is an error, set the status code accordingly
 		if e, ok := val.(*ResponseError); ok {
-			statusCode = StatusErrorMapping(e.Code)
+			statusCode = e.StatusCode()
 		}
 	}
 
-	// Write the response data in JSON format with the specified status code
-	_ = WriteJSON(w, statusCode, resp)
+	// Add the request ID of the RequestID middleware to the error response
+	if e, ok := resp.(*ResponseError); ok && e.RequestID == "" {
+		if requestID := RequestID(ctx, header); requestID != "" {
+			withRequestID := *e
+			withRequestID.RequestID = requestID
+			resp = &withRequestID
+		}
+	}
+
+	// Write the pagination links, if any
+	if p, ok := v.(*Pagination); ok && p.Link != "" {
+		header.Set("Link", p.Link)
+	}
+
+	return statusCode, resp
+}
+
+// RequestID returns the request ID of a request: the one stored in ctx by the
+// RequestID middleware (see help.RequestIDFromContext), or else the response
+// header named by help.RequestIDHeader. Every adapter renders it this way.
+//
+// Parameters:
+// - ctx: the request context, may be nil.
+// - header: the response headers, may be nil.
+//
+// Returns:
+// - The request ID, or an empty string.
+func RequestID(ctx context.Context, header http.Header) string {
+	if requestID := help.RequestIDFromContext(ctx); requestID != "" {
+		return requestID
+	}
+	return header.Get(help.RequestIDHeader())
 }
 
 // RenderStruct renders the response based on the provided data.
@@ -65,12 +164,23 @@ func RenderStruct(statusCode int, v interface{}) interface{} {
 	var resp ResponseDefault
 	switch data := v.(type) {
 	case *Pagination:
-		// If the input data is a Pagination structure, create a ResponseDefault structure with the pagination data
-		paginate := data
+		// If the input data is a Pagination structure, create a ResponseDefault structure with the pagination data.
+		// The meta is copied, so the Pagination of the caller is not modified.
+		meta := data.Meta
+		if meta.TotalPages == 0 {
+			meta.TotalPages = totalPages(meta.TotalRecords, meta.Limit)
+		}
+		resp = ResponseDefault{
+			Status: StatusMapping(statusCode),
+			Meta:   meta,
+			Data:   data.Data,
+		}
+	case *CursorPagination:
+		// If the input data is a CursorPagination structure, create a ResponseDefault structure with the cursor data
 		resp = ResponseDefault{
 			Status: StatusMapping(statusCode),
-			Meta:   paginate.Meta,
-			Data:   paginate.Data,
+			Meta:   data.Meta,
+			Data:   data.Data,
 		}
 	case MultiLanguages:
 		// If the input data is a MultiLanguages structure, create a ResponseDefault structure with the message data
@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"encoding/json"
+	"iter"
+	"net/http"
+)
+
+// Constants for streaming responses.
+const (
+	// DefaultStreamFlushEvery is the number of items written between two flushes.
+	DefaultStreamFlushEvery = 100
+	// ContentTypeNDJSON is the content type of newline delimited JSON responses.
+	ContentTypeNDJSON = "application/x-ndjson"
+)
+
+// OptionStream is a function type used for applying options to streaming responses.
+type OptionStream func(*streamConfig)
+
+// streamConfig holds the configuration of a streaming response.
+type streamConfig struct {
+	flushEvery int // flushEvery is the number of items written between two flushes.
+}
+
+// WithFlushEvery sets the number of items written between two flushes.
+// Defaults to DefaultStreamFlushEvery.
+func WithFlushEvery(n int) OptionStream {
+	return func(c *streamConfig) {
+		if n > 0 {
+			c.flushEvery = n
+		}
+	}
+}
+
+// SeqOf adapts an iter.Seq that cannot fail to the iter.Seq2 accepted by StreamJSON and StreamNDJSON.
+func SeqOf[T any](seq iter.Seq[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v := range seq {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFromChan adapts a channel to the iter.Seq2 accepted by StreamJSON and StreamNDJSON.
+// The stream ends when the channel is closed.
+func SeqFromChan[T any](ch <-chan T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v := range ch {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// StreamJSON streams the items of seq as the data array of the ResponseDefault
+// envelope, without holding the whole response in memory:
+//
+//	{"status":"Success","data":[item, item, ...]}
+//
+// The response is flushed every few items. If seq yields an error before the
+// first item, the error is rendered with RenderJSON. If it fails mid-stream, the
+// array is closed and the error is written as a terminal record:
+//
+//	{"status":"Success","data":[item, ...],"error":{"status":...,"code":...}}
+//
+// It returns the error yielded by seq, or the error of writing the response.
+func StreamJSON[T any](w http.ResponseWriter, statusCode int, seq iter.Seq2[T, error], options ...OptionStream) error {
+	s := newStreamWriter(w, options...)
+
+	for item, err := range seq {
+		var d []byte
+		if err == nil {
+			d, err = json.Marshal(item)
+		}
+		if err != nil {
+			if s.count == 0 {
+				RenderJSON(w, http.StatusInternalServerError, err)
+				return err
+			}
+			return s.fail(`],"error":`, err, "}")
+		}
+
+		separator := ","
+		if s.count == 0 {
+			status, _ := json.Marshal(StatusMapping(statusCode))
+			separator = `{"status":` + string(status) + `,"data":[`
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+		}
+
+		if err := s.item(separator, d, ""); err != nil {
+			return err
+		}
+	}
+
+	if s.count == 0 {
+		RenderJSON(w, statusCode, []T{})
+		return nil
+	}
+	return s.write([]byte("]}"))
+}
+
+// StreamNDJSON streams the items of seq as newline delimited JSON, one item per line.
+//
+// The response is flushed every few items. If seq yields an error before the
+// first item, the error is rendered with RenderJSON. If it fails mid-stream, the
+// error is written as a terminal record line:
+//
+//	{"error":{"status":...,"code":...}}
+//
+// It returns the error yielded by seq, or the error of writing the response.
+func StreamNDJSON[T any](w http.ResponseWriter, statusCode int, seq iter.Seq2[T, error], options ...OptionStream) error {
+	s := newStreamWriter(w, options...)
+
+	for item, err := range seq {
+		var d []byte
+		if err == nil {
+			d, err = json.Marshal(item)
+		}
+		if err != nil {
+			if s.count == 0 {
+				RenderJSON(w, http.StatusInternalServerError, err)
+				return err
+			}
+			return s.fail(`{"error":`, err, "}\n")
+		}
+
+		if s.count == 0 {
+			w.Header().Set("Content-Type", ContentTypeNDJSON)
+			w.WriteHeader(statusCode)
+		}
+
+		if err := s.item("", d, "\n"); err != nil {
+			return err
+		}
+	}
+
+	if s.count == 0 {
+		w.Header().Set("Content-Type", ContentTypeNDJSON)
+		w.WriteHeader(statusCode)
+	}
+	s.flush()
+	return nil
+}
+
+// streamWriter writes the items of a streaming response and flushes them periodically.
+type streamWriter struct {
+	w          http.ResponseWriter
+	rc         *http.ResponseController
+	flushEvery int
+	count      int
+}
+
+// newStreamWriter creates a streamWriter with the given options.
+func newStreamWriter(w http.ResponseWriter, options ...OptionStream) *streamWriter {
+	cfg := &streamConfig{
+		flushEvery: DefaultStreamFlushEvery,
+	}
+	// Apply any passed options to the stream.
+	for _, o := range options {
+		o(cfg)
+	}
+
+	return &streamWriter{
+		w:          w,
+		rc:         http.NewResponseController(w),
+		flushEvery: cfg.flushEvery,
+	}
+}
+
+// write writes raw bytes to the response.
+func (s *streamWriter) write(b []byte) error {
+	_, err := s.w.Write(b)
+	return err
+}
+
+// item writes a single encoded item between prefix and suffix, and flushes the response every flushEvery items.
+func (s *streamWriter) item(prefix string, d []byte, suffix string) error {
+	if err := s.write([]byte(prefix + string(d) + suffix)); err != nil {
+		return err
+	}
+
+	s.count++
+	if s.count%s.flushEvery == 0 {
+		s.flush()
+	}
+	return nil
+}
+
+// fail writes the terminal error record wrapped in prefix and suffix, flushes and returns err.
+func (s *streamWriter) fail(prefix string, err error, suffix string) error {
+	_, resp := PrepareResponse(s.w.Header(), http.StatusInternalServerError, err)
+	d, marshalErr := json.Marshal(resp)
+	if marshalErr != nil {
+		d, _ = json.Marshal(ErrInternalServerError())
+	}
+
+	if writeErr := s.write([]byte(prefix + string(d) + suffix)); writeErr != nil {
+		return writeErr
+	}
+	s.flush()
+	return err
+}
+
+// flush flushes the response when the writer supports it.
+func (s *streamWriter) flush() {
+	_ = s.rc.Flush()
+}
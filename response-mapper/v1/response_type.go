@@ -14,12 +14,18 @@ type Meta struct {
 	Limit        int `json:"limit,omitempty"`
 	TotalRecords int `json:"total_records,omitempty"`
 	TotalPages   int `json:"total_pages,omitempty"`
+
+	// Cursor pagination, see CursorPagination.
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	HasMore    *bool  `json:"has_more,omitempty"`
 }
 
 // Pagination represents the structure for paginating data.
 type Pagination struct {
 	Meta Meta
 	Data interface{}
+	Link string // RFC 8288 Link header written by RenderJSON, see WithLinks
 }
 
 // MultiLanguages represents a structure for multi-language support.
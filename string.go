@@ -1,18 +1,13 @@
 package help
 
-import (
-	"math/rand"
-	"strings"
-	"time"
-)
-
 const (
 	CharsetAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	CharsetAll      = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!@#$%^&*()+,-.:;<=>?[]_{}"
 )
 
 // GenerateRandomString generates a random string of a specified length using the alphabet
-// characters. It uses the current time as a seed for the random number generator.
+// characters, with crypto/rand. It panics if the system has no entropy, see RandomString
+// to handle the error.
 //
 // Parameters:
 // - length: The length of the string to be generated.
@@ -20,26 +15,20 @@ const (
 // Returns:
 // - A string of the specified length consisting of alphabet characters.
 func GenerateRandomString(length int) string {
-	// Define the charset for generating the random string
-	const charset = CharsetAlphabet
-
-	// Create a seeded random number generator using the current time as the seed
-	seededRand := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-	// Create a byte slice to store the generated string
-	b := make([]byte, length)
-
-	// Generate the random string by selecting a random character from the charset for each index in the byte slice
-	for i := range b {
-		b[i] = charset[seededRand.Intn(len(charset))]
+	// Generate the random string by selecting a random character from the charset for each index
+	s, err := RandomString(max(length, 0), CharsetAlphabet)
+	if err != nil {
+		panic(err)
 	}
 
 	// Return the generated string
-	return string(b)
+	return s
 }
 
 // GeneratePassword generates a random password of a specified length using a combination of
-// lowercase letters, uppercase letters, numbers, and special characters.
+// lowercase letters, uppercase letters, numbers, and special characters, with crypto/rand.
+// Passwords of at least 4 characters contain each of these classes. It panics if the system
+// has no entropy, see RandomPassword to handle the error.
 //
 // Parameters:
 // - length: The length of the password to be generated.
@@ -47,22 +36,21 @@ func GenerateRandomString(length int) string {
 // Returns:
 // - A string of the specified length consisting of random characters.
 func GeneratePassword(length int) string {
-	// Define the charset for generating the password
-	// The charset includes lowercase letters, uppercase letters, numbers, and special characters
-	const charset = CharsetAll
-
-	// Create a strings.Builder to store the generated password
-	var password strings.Builder
-
-	// Reserve memory for the password
-	password.Grow(length)
-
-	// Generate the password by selecting a random character from the charset for each index in the password
-	for i := 0; i < length; i++ {
-		// Select a random character from the charset and append it to the password
-		password.WriteByte(charset[rand.Intn(len(charset))])
+	var (
+		password string
+		err      error
+	)
+
+	// Short passwords can not contain every class, so pick them from the whole charset
+	if length < 4 {
+		password, err = RandomString(max(length, 0), CharsetAll)
+	} else {
+		password, err = RandomPassword(length)
+	}
+	if err != nil {
+		panic(err)
 	}
 
 	// Return the generated password
-	return password.String()
+	return password
 }
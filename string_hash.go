@@ -1,32 +1,30 @@
 package help
 
-import (
-	"golang.org/x/crypto/bcrypt"
-)
-
-// HashPassword generates a hashed password from a plain text password using bcrypt.
-// The function returns the hashed password and an error if any.
+// HashPassword generates a hashed password from a plain text password using DefaultPasswordHasher,
+// argon2id by default. The function returns the hashed password and an error if any.
 // check https://adamnasrudin.vercel.app/cheat-sheet/hash-validate-password
 func HashPassword(password string) (hashed string, err error) {
-	// Generate a hashed password from the plain text password using bcrypt.
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-
-	// Convert the hashed password to a string and return it.
-	return string(hash), nil
+	// Generate a hashed password from the plain text password.
+	return DefaultPasswordHasher.Hash(password)
 }
 
 // PasswordIsValid checks if a given plain text password matches the hashed password.
-// The function returns true if the passwords match, false otherwise.
+// The algorithm of the hash (argon2id, scrypt or bcrypt) is detected automatically.
+// The function returns whether the passwords match, and whether the hash needs
+// rehashing because it was not created by DefaultPasswordHasher with its current
+// parameters, e.g. a legacy bcrypt hash. Applications can upgrade hashes on login:
+//
+//	valid, needsRehash := help.PasswordIsValid(user.PasswordHash, password)
+//	if valid && needsRehash {
+//		user.PasswordHash, _ = help.HashPassword(password)
+//	}
+//
 // check https://adamnasrudin.vercel.app/cheat-sheet/hash-validate-password
-func PasswordIsValid(hashPassword, password string) bool {
-	// Convert the hashed password and plain text password to byte slices.
-	hash, pass := []byte(hashPassword), []byte(password)
-
-	// Compare the hashed password with the plain text password using bcrypt.
-	// If the passwords match, return true, otherwise return false.
-	err := bcrypt.CompareHashAndPassword(hash, pass)
-	return err == nil
+func PasswordIsValid(hashPassword, password string) (valid, needsRehash bool) {
+	// Compare the hashed password with the plain text password.
+	valid, err := VerifyPassword(hashPassword, password)
+	if err != nil || !valid {
+		return false, false
+	}
+	return true, DefaultPasswordHasher.NeedsRehash(hashPassword)
 }
@@ -0,0 +1,36 @@
+package help
+
+import (
+	"strings"
+	"time"
+)
+
+// FormatTimeID formats a time with the given layout and translates the English
+// month names to Indonesian, e.g. "02 January 2006" gives "17 Agustus 2024".
+// Abbreviated months of layouts such as FormatLocalTime become the first three
+// letters of the Indonesian name, e.g. "17-Agu-2024".
+//
+// Parameters:
+// - t: The time to format.
+// - layout: The layout of the time, e.g. FormatDateMonthYear.
+//
+// Returns:
+// - The formatted time with Indonesian month names.
+func FormatTimeID(t time.Time, layout string) string {
+	formatted := t.Format(layout)
+
+	monthEn := t.Month().String()
+	monthId, ok := MappingMonthEnToId[monthEn]
+	if !ok {
+		return formatted
+	}
+
+	// Translate the full month name first, so "January" is not caught by "Jan".
+	if strings.Contains(layout, "January") {
+		return strings.ReplaceAll(formatted, monthEn, monthId)
+	}
+	if strings.Contains(layout, "Jan") {
+		return strings.ReplaceAll(formatted, monthEn[:3], monthId[:3])
+	}
+	return formatted
+}
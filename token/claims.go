@@ -0,0 +1,119 @@
+package token
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Audience is the aud claim, encoded as a string when it has a single value.
+type Audience []string
+
+// MarshalJSON encodes a single audience as a string and several as an array.
+func (a Audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON decodes an audience that is a string or an array of strings.
+func (a *Audience) UnmarshalJSON(d []byte) error {
+	var single string
+	if err := json.Unmarshal(d, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(d, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+// Claims holds the registered claims of a token and the custom ones in Extra.
+// Times are Unix seconds.
+type Claims struct {
+	Issuer    string   `json:"iss,omitempty"`
+	Subject   string   `json:"sub,omitempty"`
+	Audience  Audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	ID        string   `json:"jti,omitempty"`
+	Scope     string   `json:"scope,omitempty"` // Scope is the space separated list of granted scopes.
+
+	// Extra holds the custom claims, encoded next to the registered ones.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// registeredClaims has the fields of Claims without its methods, to encode them with encoding/json.
+type registeredClaims Claims
+
+// registeredNames lists the json names of the fields of Claims.
+var registeredNames = []string{"iss", "sub", "aud", "exp", "nbf", "iat", "jti", "scope"}
+
+// MarshalJSON encodes the registered claims and the custom claims of Extra in a single object.
+func (c Claims) MarshalJSON() ([]byte, error) {
+	d, err := json.Marshal(registeredClaims(c))
+	if err != nil || len(c.Extra) == 0 {
+		return d, err
+	}
+
+	all := map[string]interface{}{}
+	for k, v := range c.Extra {
+		all[k] = v
+	}
+	var registered map[string]interface{}
+	if err := json.Unmarshal(d, &registered); err != nil {
+		return nil, err
+	}
+	for k, v := range registered {
+		all[k] = v
+	}
+	return json.Marshal(all)
+}
+
+// UnmarshalJSON decodes the registered claims, and the others into Extra.
+func (c *Claims) UnmarshalJSON(d []byte) error {
+	var registered registeredClaims
+	if err := json.Unmarshal(d, &registered); err != nil {
+		return err
+	}
+	var all map[string]interface{}
+	if err := json.Unmarshal(d, &all); err != nil {
+		return err
+	}
+
+	*c = Claims(registered)
+	for k, v := range all {
+		if slices.Contains(registeredNames, k) {
+			continue
+		}
+		if c.Extra == nil {
+			c.Extra = map[string]interface{}{}
+		}
+		c.Extra[k] = v
+	}
+	return nil
+}
+
+// HasScope reports whether the scope claim contains the given scope.
+func (c *Claims) HasScope(scope string) bool {
+	return slices.Contains(strings.Fields(c.Scope), scope)
+}
+
+// HasAudience reports whether the aud claim contains the given audience.
+func (c *Claims) HasAudience(audience string) bool {
+	return slices.Contains(c.Audience, audience)
+}
+
+// ExpiresTime returns the exp claim as a time, or the zero time when it is not set.
+func (c *Claims) ExpiresTime() time.Time {
+	if c.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.ExpiresAt, 0)
+}
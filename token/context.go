@@ -0,0 +1,18 @@
+package token
+
+import "context"
+
+// claimsKey is the context key of the claims.
+type claimsKey struct{}
+
+// ContextWithClaims returns a copy of the context that carries the claims.
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims stored in the context by the
+// Authenticate middleware, or nil.
+func ClaimsFromContext(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(claimsKey{}).(*Claims)
+	return claims
+}
@@ -0,0 +1,155 @@
+// Package token issues and verifies JSON Web Tokens signed with HS256, RS256
+// or EdDSA, with key rotation by key ID (kid). Verification errors are
+// response-mapper errors of type ErrUnauthorized.
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+)
+
+// Algorithm is the signing algorithm of a token, the alg header.
+type Algorithm string
+
+// The supported algorithms.
+const (
+	HS256 Algorithm = "HS256" // HS256 is HMAC with SHA-256, with a shared secret.
+	RS256 Algorithm = "RS256" // RS256 is RSASSA-PKCS1-v1_5 with SHA-256.
+	EdDSA Algorithm = "EdDSA" // EdDSA is Ed25519.
+)
+
+// minHS256SecretLength is the minimum length of HS256 secrets, the size of the hash.
+const minHS256SecretLength = 32
+
+// minRSAKeyBits is the minimum size of RS256 keys, as recommended by NIST.
+const minRSAKeyBits = 2048
+
+// Errors returned when creating keys and signing.
+var (
+	// ErrWeakSecret is returned for HS256 secrets shorter than 32 bytes, and
+	// when signing with an HS256 Key not created by NewHS256Key.
+	ErrWeakSecret = errors.New("token: HS256 secret must be at least 32 bytes")
+	// ErrWeakRSAKey is returned for RS256 keys smaller than 2048 bits.
+	ErrWeakRSAKey = errors.New("token: RS256 key must be at least 2048 bits")
+	// ErrInvalidKey is returned for nil or malformed keys.
+	ErrInvalidKey = errors.New("token: invalid key")
+	// ErrVerifyOnlyKey is returned when signing with a key that only has a public key.
+	ErrVerifyOnlyKey = errors.New("token: key can not sign")
+)
+
+// Key is a signing or verification key identified by its key ID. Create it
+// with the NewXxxKey functions, a Key literal has no key material and neither
+// signs nor verifies.
+type Key struct {
+	ID        string    // ID is the key ID written in the kid header.
+	Algorithm Algorithm // Algorithm is the only algorithm accepted with this key.
+
+	secret     []byte
+	rsaPrivate *rsa.PrivateKey
+	rsaPublic  *rsa.PublicKey
+	edPrivate  ed25519.PrivateKey
+	edPublic   ed25519.PublicKey
+}
+
+// NewHS256Key creates an HS256 key from a secret of at least 32 bytes.
+func NewHS256Key(id string, secret []byte) (*Key, error) {
+	if len(secret) < minHS256SecretLength {
+		return nil, ErrWeakSecret
+	}
+	return &Key{ID: id, Algorithm: HS256, secret: secret}, nil
+}
+
+// NewRS256Key creates an RS256 key that signs and verifies, from a key of at least 2048 bits.
+func NewRS256Key(id string, private *rsa.PrivateKey) (*Key, error) {
+	if private == nil {
+		return nil, ErrInvalidKey
+	}
+	if err := checkRSAPublicKey(&private.PublicKey); err != nil {
+		return nil, err
+	}
+	return &Key{ID: id, Algorithm: RS256, rsaPrivate: private, rsaPublic: &private.PublicKey}, nil
+}
+
+// NewRS256PublicKey creates an RS256 key that only verifies, e.g. in services that do not issue tokens.
+func NewRS256PublicKey(id string, public *rsa.PublicKey) (*Key, error) {
+	if err := checkRSAPublicKey(public); err != nil {
+		return nil, err
+	}
+	return &Key{ID: id, Algorithm: RS256, rsaPublic: public}, nil
+}
+
+// NewEdDSAKey creates an EdDSA key that signs and verifies.
+func NewEdDSAKey(id string, private ed25519.PrivateKey) (*Key, error) {
+	if len(private) != ed25519.PrivateKeySize {
+		return nil, ErrInvalidKey
+	}
+	return &Key{ID: id, Algorithm: EdDSA, edPrivate: private, edPublic: private.Public().(ed25519.PublicKey)}, nil
+}
+
+// NewEdDSAPublicKey creates an EdDSA key that only verifies.
+func NewEdDSAPublicKey(id string, public ed25519.PublicKey) (*Key, error) {
+	if len(public) != ed25519.PublicKeySize {
+		return nil, ErrInvalidKey
+	}
+	return &Key{ID: id, Algorithm: EdDSA, edPublic: public}, nil
+}
+
+// checkRSAPublicKey checks that an RSA key is set and at least 2048 bits.
+func checkRSAPublicKey(public *rsa.PublicKey) error {
+	if public == nil || public.N == nil {
+		return ErrInvalidKey
+	}
+	if public.N.BitLen() < minRSAKeyBits {
+		return ErrWeakRSAKey
+	}
+	return nil
+}
+
+// sign returns the signature of the signing input.
+func (k *Key) sign(input []byte) ([]byte, error) {
+	switch k.Algorithm {
+	case HS256:
+		if len(k.secret) < minHS256SecretLength {
+			return nil, ErrWeakSecret
+		}
+		mac := hmac.New(sha256.New, k.secret)
+		mac.Write(input)
+		return mac.Sum(nil), nil
+	case RS256:
+		if k.rsaPrivate == nil {
+			return nil, ErrVerifyOnlyKey
+		}
+		sum := sha256.Sum256(input)
+		return rsa.SignPKCS1v15(rand.Reader, k.rsaPrivate, crypto.SHA256, sum[:])
+	case EdDSA:
+		if k.edPrivate == nil {
+			return nil, ErrVerifyOnlyKey
+		}
+		return ed25519.Sign(k.edPrivate, input), nil
+	}
+	return nil, ErrVerifyOnlyKey
+}
+
+// verify reports whether the signature of the signing input is valid.
+func (k *Key) verify(input, signature []byte) bool {
+	switch k.Algorithm {
+	case HS256:
+		if len(k.secret) < minHS256SecretLength {
+			return false
+		}
+		mac := hmac.New(sha256.New, k.secret)
+		mac.Write(input)
+		return hmac.Equal(mac.Sum(nil), signature)
+	case RS256:
+		sum := sha256.Sum256(input)
+		return k.rsaPublic != nil && rsa.VerifyPKCS1v15(k.rsaPublic, crypto.SHA256, sum[:], signature) == nil
+	case EdDSA:
+		return len(k.edPublic) == ed25519.PublicKeySize && ed25519.Verify(k.edPublic, input, signature)
+	}
+	return false
+}
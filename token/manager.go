@@ -0,0 +1,316 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	response_mapper "github.com/adamnasrudin03/go-helpers/response-mapper/v1"
+)
+
+// Default parameters of the Manager.
+const (
+	DefaultTTL    = 15 * time.Minute
+	DefaultLeeway = time.Minute
+)
+
+// Errors wrapped as the cause of the ErrTokenInvalid responses of Verify.
+var (
+	ErrMalformed        = errors.New("token: malformed token")
+	ErrUnknownKey       = errors.New("token: unknown key id")
+	ErrAlgorithm        = errors.New("token: unexpected algorithm")
+	ErrSignature        = errors.New("token: invalid signature")
+	ErrNotYetValid      = errors.New("token: token is not valid yet")
+	ErrIssuer           = errors.New("token: invalid issuer")
+	ErrAudience         = errors.New("token: invalid audience")
+	ErrNoExpirationTime = errors.New("token: token has no expiration time")
+)
+
+// header is the JOSE header of a token.
+type header struct {
+	Algorithm Algorithm `json:"alg"`
+	Type      string    `json:"typ,omitempty"`
+	KeyID     string    `json:"kid,omitempty"`
+}
+
+// OptionManager is a function type used for applying options to the Manager.
+type OptionManager func(*Manager)
+
+// WithIssuer sets the iss claim of signed tokens, which verified tokens must have.
+func WithIssuer(issuer string) OptionManager {
+	return func(m *Manager) {
+		m.issuer = issuer
+	}
+}
+
+// WithAudience sets the aud claim of signed tokens. Verified tokens must have
+// at least one of the audiences.
+func WithAudience(audience ...string) OptionManager {
+	return func(m *Manager) {
+		m.audience = audience
+	}
+}
+
+// WithTTL sets how long signed tokens are valid when their exp claim is not set. Defaults to 15 minutes.
+func WithTTL(ttl time.Duration) OptionManager {
+	return func(m *Manager) {
+		if ttl > 0 {
+			m.ttl = ttl
+		}
+	}
+}
+
+// WithLeeway sets the clock skew allowed when checking the exp and nbf claims. Defaults to 1 minute.
+func WithLeeway(leeway time.Duration) OptionManager {
+	return func(m *Manager) {
+		if leeway >= 0 {
+			m.leeway = leeway
+		}
+	}
+}
+
+// WithVerificationKeys adds keys that verify tokens but do not sign them,
+// e.g. the previous keys after a rotation or the public keys of another issuer.
+func WithVerificationKeys(keys ...*Key) OptionManager {
+	return func(m *Manager) {
+		for _, k := range keys {
+			if k != nil {
+				m.keys[k.ID] = k
+			}
+		}
+	}
+}
+
+// WithClock sets the function returning the current time. Defaults to time.Now.
+func WithClock(now func() time.Time) OptionManager {
+	return func(m *Manager) {
+		m.now = now
+	}
+}
+
+// Manager signs and verifies tokens. The kid header selects the verification
+// key, so keys can be rotated without invalidating the tokens already issued.
+// A Manager is safe for concurrent use.
+type Manager struct {
+	mu       sync.RWMutex
+	signing  *Key
+	keys     map[string]*Key
+	issuer   string
+	audience []string
+	ttl      time.Duration
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// NewManager creates a Manager that signs with the key. The key can be nil
+// for a Manager that only verifies, with WithVerificationKeys.
+func NewManager(signing *Key, options ...OptionManager) *Manager {
+	m := &Manager{
+		keys:   map[string]*Key{},
+		ttl:    DefaultTTL,
+		leeway: DefaultLeeway,
+		now:    time.Now,
+	}
+	if signing != nil {
+		m.signing = signing
+		m.keys[signing.ID] = signing
+	}
+	// Apply any passed options to the manager.
+	for _, o := range options {
+		o(m)
+	}
+	return m
+}
+
+// Rotate makes the key the signing key. The previous keys still verify the
+// tokens they signed until they are removed with RemoveKey.
+// It returns ErrInvalidKey if the key is nil.
+func (m *Manager) Rotate(key *Key) error {
+	if key == nil {
+		return ErrInvalidKey
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.signing = key
+	m.keys[key.ID] = key
+	return nil
+}
+
+// RemoveKey removes a verification key, e.g. once the tokens it signed have expired.
+// The signing key can not be removed.
+func (m *Manager) RemoveKey(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.signing != nil && m.signing.ID == id {
+		return
+	}
+	delete(m.keys, id)
+}
+
+// Sign signs the claims with the signing key and returns the token.
+// It sets the iat claim, and the iss, aud and exp claims when they are empty.
+//
+// Parameters:
+// - claims: The claims of the token.
+//
+// Returns:
+// - The signed token.
+// - An error if there is no signing key or the claims can not be encoded.
+func (m *Manager) Sign(claims Claims) (string, error) {
+	m.mu.RLock()
+	key := m.signing
+	m.mu.RUnlock()
+	if key == nil {
+		return "", ErrVerifyOnlyKey
+	}
+
+	now := m.now()
+	claims.IssuedAt = now.Unix()
+	if claims.Issuer == "" {
+		claims.Issuer = m.issuer
+	}
+	if len(claims.Audience) == 0 && len(m.audience) > 0 {
+		claims.Audience = m.audience
+	}
+	if claims.ExpiresAt == 0 {
+		claims.ExpiresAt = now.Add(m.ttl).Unix()
+	}
+
+	h, err := json.Marshal(header{Algorithm: key.Algorithm, Type: "JWT", KeyID: key.ID})
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	input := encodeSegment(h) + "." + encodeSegment(c)
+	signature, err := key.sign([]byte(input))
+	if err != nil {
+		return "", err
+	}
+	return input + "." + encodeSegment(signature), nil
+}
+
+// Verify checks the signature of the token and its exp, nbf, iss and aud
+// claims, and returns its claims.
+//
+// The alg header must be the algorithm of the key selected by the kid header,
+// so "none" and algorithm confusion attacks are rejected.
+//
+// Parameters:
+// - token: The token to verify.
+//
+// Returns:
+// - The claims of the token.
+// - response_mapper.ErrTokenExpired if the token has expired, or
+// response_mapper.ErrTokenInvalid with the reason as its cause. The cause is
+// not logged, since tokens come from clients.
+func (m *Manager) Verify(token string) (*Claims, error) {
+	claims, err := m.verify(token)
+	if err != nil {
+		var responseErr *response_mapper.ResponseError
+		if errors.As(err, &responseErr) {
+			return nil, err
+		}
+		return nil, response_mapper.ErrTokenInvalid().WithCauseNoLog(err)
+	}
+	return claims, nil
+}
+
+// verify is Verify with the reasons as plain errors.
+func (m *Manager) verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	var h header
+	if err := decodeJSONSegment(parts[0], &h); err != nil {
+		return nil, err
+	}
+
+	key, err := m.key(h.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if h.Algorithm != key.Algorithm {
+		return nil, ErrAlgorithm
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	if !key.verify([]byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrSignature
+	}
+
+	claims := &Claims{}
+	if err := decodeJSONSegment(parts[1], claims); err != nil {
+		return nil, err
+	}
+	return claims, m.validate(claims)
+}
+
+// key returns the verification key of the key ID. Tokens without kid are
+// verified with the signing key.
+func (m *Manager) key(id string) (*Key, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if id == "" && m.signing != nil {
+		return m.signing, nil
+	}
+	if key, ok := m.keys[id]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// validate checks the time, issuer and audience claims.
+func (m *Manager) validate(claims *Claims) error {
+	now := m.now()
+	if claims.ExpiresAt == 0 {
+		return ErrNoExpirationTime
+	}
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(m.leeway)) {
+		return response_mapper.ErrTokenExpired()
+	}
+	if claims.NotBefore != 0 && now.Add(m.leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return ErrNotYetValid
+	}
+	if m.issuer != "" && claims.Issuer != m.issuer {
+		return ErrIssuer
+	}
+	if len(m.audience) > 0 {
+		for _, a := range m.audience {
+			if claims.HasAudience(a) {
+				return nil
+			}
+		}
+		return ErrAudience
+	}
+	return nil
+}
+
+// encodeSegment encodes a segment of a token in unpadded base64url.
+func encodeSegment(d []byte) string {
+	return base64.RawURLEncoding.EncodeToString(d)
+}
+
+// decodeJSONSegment decodes an unpadded base64url JSON segment of a token.
+func decodeJSONSegment(segment string, v interface{}) error {
+	d, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformed
+	}
+	if err := json.Unmarshal(d, v); err != nil {
+		return ErrMalformed
+	}
+	return nil
+}
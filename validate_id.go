@@ -0,0 +1,131 @@
+package help
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// provinceCodesId holds the province codes of Indonesia, the first two digits of a NIK.
+var provinceCodesId = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "36": true,
+	"51": true, "52": true, "53": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true,
+	"71": true, "72": true, "73": true, "74": true, "75": true, "76": true,
+	"81": true, "82": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true,
+}
+
+var (
+	// digitsPattern matches a string of digits only.
+	digitsPattern = regexp.MustCompile(`^\d+$`)
+	// postalCodeIdPattern matches the 5 digits of an Indonesian postal code, which never starts with 0.
+	postalCodeIdPattern = regexp.MustCompile(`^[1-9]\d{4}$`)
+	// plateNumberIdPattern matches an Indonesian vehicle registration plate, e.g. "B 1234 ABC".
+	plateNumberIdPattern = regexp.MustCompile(`^[A-Z]{1,2} ?[1-9]\d{0,3} ?[A-Z]{0,3}$`)
+)
+
+// IsNIK checks if the given string is a valid Indonesian NIK (Nomor Induk Kependudukan).
+//
+// Parameters:
+// - input: The string to be validated.
+//
+// Returns:
+// - A boolean indicating whether the string is a valid NIK or not.
+//
+// A NIK has 16 digits: the province, regency and district codes, the date of
+// birth as DDMMYY (40 is added to the day for women), and a serial number.
+// The function checks the province code, that the regency, district and serial
+// number are not zero, and that the date of birth is a real date.
+func IsNIK(input string) bool {
+	if len(input) != 16 || !digitsPattern.MatchString(input) {
+		return false
+	}
+
+	// Check the province, regency and district codes.
+	if !provinceCodesId[input[0:2]] || input[2:4] == "00" || input[4:6] == "00" {
+		return false
+	}
+
+	// Check the date of birth, the day of women is increased by 40.
+	day, _ := strconv.Atoi(input[6:8])
+	month, _ := strconv.Atoi(input[8:10])
+	if day > 40 {
+		day -= 40
+	}
+	if month < 1 || month > 12 || day < 1 || day > daysInMonth(month) {
+		return false
+	}
+
+	// Check the serial number.
+	return input[12:16] != "0000"
+}
+
+// daysInMonth returns the maximum number of days of a month, with 29 days for February
+// since the two-digit year of a NIK can not tell leap years apart.
+func daysInMonth(month int) int {
+	switch month {
+	case 2:
+		return 29
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
+// IsNPWP checks if the given string is a valid Indonesian NPWP (Nomor Pokok Wajib Pajak).
+//
+// Parameters:
+// - input: The string to be validated, with or without the dots and dashes of
+// the formatted NPWP, e.g. "01.234.567.8-901.000".
+//
+// Returns:
+// - A boolean indicating whether the string is a valid NPWP or not.
+//
+// Both the 15-digit NPWP and the 16-digit NPWP used since 2024 are valid. A
+// 16-digit NPWP is either the NIK of the taxpayer or a 15-digit NPWP prefixed with 0.
+func IsNPWP(input string) bool {
+	npwp := strings.NewReplacer(".", "", "-", "", " ", "").Replace(input)
+	if !digitsPattern.MatchString(npwp) {
+		return false
+	}
+
+	switch len(npwp) {
+	case 15:
+		return npwp[0:9] != "000000000"
+	case 16:
+		if npwp[0] == '0' {
+			return npwp[1:10] != "000000000"
+		}
+		return IsNIK(npwp)
+	}
+	return false
+}
+
+// IsPostalCodeId checks if the given string is a valid Indonesian postal code.
+//
+// Parameters:
+// - input: The string to be validated.
+//
+// Returns:
+// - A boolean indicating whether the string is a valid postal code or not.
+func IsPostalCodeId(input string) bool {
+	return postalCodeIdPattern.MatchString(input)
+}
+
+// IsPlateNumberId checks if the given string is a valid Indonesian vehicle registration plate.
+//
+// Parameters:
+// - input: The string to be validated, e.g. "B 1234 ABC" or "d1234xy".
+//
+// Returns:
+// - A boolean indicating whether the string is a valid plate number or not.
+//
+// A plate has a 1 or 2 letter region code, 1 to 4 digits and up to 3 letters.
+// Letters are case insensitive and repeated spaces are ignored.
+func IsPlateNumberId(input string) bool {
+	plate := strings.ToUpper(strings.Join(strings.Fields(input), " "))
+	return plateNumberIdPattern.MatchString(plate)
+}
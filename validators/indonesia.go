@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"fmt"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	"github.com/go-playground/validator/v10"
+)
+
+// indonesianValidation describes a validation tag for Indonesian identifiers.
+type indonesianValidation struct {
+	tag   string
+	check func(string) bool
+	en    string // en is the English name of the identifier.
+	id    string // id is the Indonesian name of the identifier.
+}
+
+// indonesianValidations lists the tags registered by RegisterIndonesianValidations.
+var indonesianValidations = []indonesianValidation{
+	{tag: "id_phone", check: help.IsPhoneNumberId, en: "Indonesian phone number", id: "nomor telepon Indonesia"},
+	{tag: "nik", check: help.IsNIK, en: "NIK", id: "NIK"},
+	{tag: "npwp", check: help.IsNPWP, en: "NPWP", id: "NPWP"},
+	{tag: "id_postal_code", check: help.IsPostalCodeId, en: "Indonesian postal code", id: "kode pos Indonesia"},
+	{tag: "id_plate", check: help.IsPlateNumberId, en: "Indonesian plate number", id: "nomor polisi Indonesia"},
+}
+
+// RegisterIndonesianValidations registers the validation tags of Indonesian
+// identifiers, with their English and Indonesian messages:
+//
+//   - id_phone: a phone number, see help.IsPhoneNumberId.
+//   - nik: a 16-digit NIK, see help.IsNIK.
+//   - npwp: a 15 or 16-digit NPWP, see help.IsNPWP.
+//   - id_postal_code: a 5-digit postal code, see help.IsPostalCodeId.
+//   - id_plate: a vehicle registration plate, see help.IsPlateNumberId.
+//
+// The tags validate string fields, e.g. `validate:"required,nik"`.
+func RegisterIndonesianValidations(v *validator.Validate) error {
+	for _, iv := range indonesianValidations {
+		iv := iv
+		err := v.RegisterValidation(iv.tag, func(fl validator.FieldLevel) bool {
+			return iv.check(fl.Field().String())
+		})
+		if err != nil {
+			return err
+		}
+
+		RegisterMessage(iv.tag, func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be a valid %s", fieldName(e), iv.en)
+		})
+		RegisterMessageLang(LangID, iv.tag, func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berupa %s yang valid", fieldName(e), iv.id)
+		})
+	}
+	return nil
+}
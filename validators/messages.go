@@ -0,0 +1,92 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// The languages of the message registry.
+const (
+	LangEN = "en" // LangEN is the language of the built-in messages.
+	LangID = "id" // LangID is the language of the Indonesian messages.
+)
+
+// MessageFunc builds the message of a failed validation.
+type MessageFunc func(e validator.FieldError) string
+
+// messageRegistry holds the message functions by language and tag.
+type messageRegistry struct {
+	mu       sync.RWMutex
+	messages map[string]map[string]MessageFunc
+}
+
+// registry is the registry used by FormatErrorValidatorSingle and Message.
+var registry = &messageRegistry{
+	messages: map[string]map[string]MessageFunc{
+		LangEN: builtinMessagesEN(),
+		LangID: builtinMessagesID(),
+	},
+}
+
+// RegisterMessage registers the English message of a validation tag, e.g. a custom tag
+// registered with validator.RegisterValidation. It replaces the message of built-in tags.
+func RegisterMessage(tag string, fn MessageFunc) {
+	RegisterMessageLang(LangEN, tag, fn)
+}
+
+// RegisterMessageLang registers the message of a validation tag in the given language.
+func RegisterMessageLang(lang, tag string, fn MessageFunc) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if registry.messages[lang] == nil {
+		registry.messages[lang] = map[string]MessageFunc{}
+	}
+	registry.messages[lang][tag] = fn
+}
+
+// RegisterMessageTemplate registers the English message of a validation tag from a template,
+// where {field} is replaced by the field name and {param} by the tag parameter,
+// e.g. "{field} must be a valid SKU".
+func RegisterMessageTemplate(tag, template string) {
+	RegisterMessage(tag, TemplateMessage(template))
+}
+
+// TemplateMessage returns a MessageFunc that replaces {field} and {param} in template.
+func TemplateMessage(template string) MessageFunc {
+	return func(e validator.FieldError) string {
+		return strings.NewReplacer("{field}", fieldName(e), "{param}", e.Param()).Replace(template)
+	}
+}
+
+// LookupMessage returns the message of a validation error in the given
+// language, and false when no message is registered for its tag.
+func LookupMessage(lang string, e validator.FieldError) (string, bool) {
+	registry.mu.RLock()
+	fn, ok := registry.messages[lang][e.Tag()]
+	registry.mu.RUnlock()
+
+	if !ok {
+		return "", false
+	}
+	return fn(e), true
+}
+
+// Message returns the message of a validation error in the given language.
+// It falls back to the English message, then to a generic message naming the tag.
+func Message(lang string, e validator.FieldError) string {
+	if msg, ok := LookupMessage(lang, e); ok {
+		return msg
+	}
+	if msg, ok := LookupMessage(LangEN, e); ok {
+		return msg
+	}
+
+	if e.Param() != "" {
+		return fmt.Sprintf("%s failed on the %s=%s validation", fieldName(e), e.Tag(), e.Param())
+	}
+	return fmt.Sprintf("%s failed on the %s validation", fieldName(e), e.Tag())
+}
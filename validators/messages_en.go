@@ -0,0 +1,406 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// timeType is the reflect.Type of time.Time.
+var timeType = reflect.TypeOf(time.Time{})
+
+// paramValues matches the values of a tag parameter, which may be quoted, e.g. oneof='a b' c.
+var paramValues = regexp.MustCompile(`'[^']*'|\S+`)
+
+// formatNamesEN maps the format tags to the name of the expected format,
+// used as "<field> must be a valid <name>".
+var formatNamesEN = map[string]string{
+	"alpha":                         "alphabetic string",
+	"alphanum":                      "alphanumeric string",
+	"alphaunicode":                  "alphabetic unicode string",
+	"alphanumunicode":               "alphanumeric unicode string",
+	"ascii":                         "ASCII string",
+	"printascii":                    "printable ASCII string",
+	"multibyte":                     "multibyte string",
+	"boolean":                       "boolean",
+	"number":                        "number",
+	"numeric":                       "numeric value",
+	"hexadecimal":                   "hexadecimal string",
+	"lowercase":                     "lowercase string",
+	"uppercase":                     "uppercase string",
+	"email":                         "email address",
+	"e164":                          "phone number in E.164 format",
+	"url":                           "URL",
+	"http_url":                      "HTTP URL",
+	"uri":                           "URI",
+	"urn_rfc2141":                   "URN",
+	"datauri":                       "data URI",
+	"base64":                        "Base64 string",
+	"base64url":                     "Base64 URL string",
+	"base64rawurl":                  "raw Base64 URL string",
+	"base32":                        "Base32 string",
+	"json":                          "JSON string",
+	"jwt":                           "JWT",
+	"html":                          "HTML",
+	"html_encoded":                  "HTML encoded string",
+	"url_encoded":                   "URL encoded string",
+	"uuid":                          "UUID",
+	"uuid3":                         "version 3 UUID",
+	"uuid4":                         "version 4 UUID",
+	"uuid5":                         "version 5 UUID",
+	"uuid_rfc4122":                  "RFC 4122 UUID",
+	"uuid3_rfc4122":                 "version 3 RFC 4122 UUID",
+	"uuid4_rfc4122":                 "version 4 RFC 4122 UUID",
+	"uuid5_rfc4122":                 "version 5 RFC 4122 UUID",
+	"ulid":                          "ULID",
+	"md4":                           "MD4 hash",
+	"md5":                           "MD5 hash",
+	"sha256":                        "SHA256 hash",
+	"sha384":                        "SHA384 hash",
+	"sha512":                        "SHA512 hash",
+	"ripemd128":                     "RIPEMD-128 hash",
+	"ripemd160":                     "RIPEMD-160 hash",
+	"tiger128":                      "TIGER128 hash",
+	"tiger160":                      "TIGER160 hash",
+	"tiger192":                      "TIGER192 hash",
+	"hexcolor":                      "HEX color",
+	"rgb":                           "RGB color",
+	"rgba":                          "RGBA color",
+	"hsl":                           "HSL color",
+	"hsla":                          "HSLA color",
+	"iscolor":                       "color",
+	"isbn":                          "ISBN number",
+	"isbn10":                        "ISBN-10 number",
+	"isbn13":                        "ISBN-13 number",
+	"issn":                          "ISSN number",
+	"ssn":                           "SSN number",
+	"credit_card":                   "credit card number",
+	"luhn_checksum":                 "number with a valid Luhn checksum",
+	"btc_addr":                      "Bitcoin address",
+	"btc_addr_bech32":               "Bech32 Bitcoin address",
+	"eth_addr":                      "Ethereum address",
+	"eth_addr_checksum":             "checksummed Ethereum address",
+	"latitude":                      "latitude",
+	"longitude":                     "longitude",
+	"country_code":                  "country code",
+	"iso3166_1_alpha2":              "ISO 3166-1 alpha-2 country code",
+	"iso3166_1_alpha3":              "ISO 3166-1 alpha-3 country code",
+	"iso3166_1_alpha_numeric":       "ISO 3166-1 numeric country code",
+	"eu_country_code":               "EU country code",
+	"iso3166_1_alpha2_eu":           "ISO 3166-1 alpha-2 EU country code",
+	"iso3166_1_alpha3_eu":           "ISO 3166-1 alpha-3 EU country code",
+	"iso3166_1_alpha_numeric_eu":    "ISO 3166-1 numeric EU country code",
+	"iso3166_2":                     "ISO 3166-2 subdivision code",
+	"iso4217":                       "ISO 4217 currency code",
+	"iso4217_numeric":               "ISO 4217 numeric currency code",
+	"bcp47_language_tag":            "BCP 47 language tag",
+	"bic":                           "BIC (SWIFT) code",
+	"timezone":                      "time zone",
+	"semver":                        "semantic version",
+	"cve":                           "CVE identifier",
+	"cron":                          "cron expression",
+	"mongodb":                       "MongoDB ObjectID",
+	"mongodb_connection_string":     "MongoDB connection string",
+	"postcode_iso3166_alpha2":       "postal code",
+	"postcode_iso3166_alpha2_field": "postal code",
+	"ip":                            "IP address",
+	"ipv4":                          "IPv4 address",
+	"ipv6":                          "IPv6 address",
+	"ip_addr":                       "resolvable IP address",
+	"ip4_addr":                      "resolvable IPv4 address",
+	"ip6_addr":                      "resolvable IPv6 address",
+	"cidr":                          "CIDR notation",
+	"cidrv4":                        "IPv4 CIDR notation",
+	"cidrv6":                        "IPv6 CIDR notation",
+	"tcp_addr":                      "TCP address",
+	"tcp4_addr":                     "TCPv4 address",
+	"tcp6_addr":                     "TCPv6 address",
+	"udp_addr":                      "UDP address",
+	"udp4_addr":                     "UDPv4 address",
+	"udp6_addr":                     "UDPv6 address",
+	"unix_addr":                     "Unix domain socket address",
+	"mac":                           "MAC address",
+	"hostname":                      "hostname",
+	"hostname_rfc1123":              "RFC 1123 hostname",
+	"dns_rfc1035_label":             "RFC 1035 DNS label",
+	"hostname_port":                 "host and port",
+	"fqdn":                          "fully qualified domain name",
+	"port":                          "port number",
+	"file":                          "existing file path",
+	"filepath":                      "file path",
+	"image":                         "image file",
+	"dir":                           "existing directory",
+	"dirpath":                       "directory path",
+}
+
+// spiceDBNamesEN maps the parameters of the spicedb tag to the name of the expected value.
+var spiceDBNamesEN = map[string]string{
+	"":           "object ID",
+	"id":         "object ID",
+	"permission": "permission",
+	"type":       "object type",
+}
+
+// builtinMessagesEN returns the English messages of the go-playground built-in tags.
+func builtinMessagesEN() map[string]MessageFunc {
+	messages := map[string]MessageFunc{
+		// presence
+		"required": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is a required field", fieldName(e))
+		},
+		"required_if": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required when %s", fieldName(e), conditionsEN(e))
+		},
+		"required_unless": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required unless %s", fieldName(e), conditionsEN(e))
+		},
+		"required_with": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required when %s is present", fieldName(e), joinFields(e, "or"))
+		},
+		"required_with_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required when %s are present", fieldName(e), joinFields(e, "and"))
+		},
+		"required_without": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required when %s is not present", fieldName(e), joinFields(e, "or"))
+		},
+		"required_without_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required when none of %s are present", fieldName(e), joinFields(e, "and"))
+		},
+		"excluded_if": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty when %s", fieldName(e), conditionsEN(e))
+		},
+		"excluded_unless": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty unless %s", fieldName(e), conditionsEN(e))
+		},
+		"excluded_with": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty when %s is present", fieldName(e), joinFields(e, "or"))
+		},
+		"excluded_with_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty when %s are present", fieldName(e), joinFields(e, "and"))
+		},
+		"excluded_without": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty when %s is not present", fieldName(e), joinFields(e, "or"))
+		},
+		"excluded_without_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty when none of %s are present", fieldName(e), joinFields(e, "and"))
+		},
+		"skip_unless": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s is required when %s", fieldName(e), conditionsEN(e))
+		},
+		"isdefault": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be empty", fieldName(e))
+		},
+
+		// size and value comparisons
+		"len": func(e validator.FieldError) string {
+			return sizeMessageEN(e, "must be exactly", "must contain exactly", "must be equal to", "")
+		},
+		"min": func(e validator.FieldError) string {
+			return sizeMessageEN(e, "must be at least", "must contain at least", "must be", " or greater")
+		},
+		"max": func(e validator.FieldError) string {
+			return sizeMessageEN(e, "must be at most", "must contain at most", "must be", " or less")
+		},
+		"gt": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s must be after %s", fieldName(e), timeParamEN(e.Param()))
+			}
+			return sizeMessageEN(e, "must be longer than", "must contain more than", "must be greater than", "")
+		},
+		"gte": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s must be at or after %s", fieldName(e), timeParamEN(e.Param()))
+			}
+			return sizeMessageEN(e, "must be at least", "must contain at least", "must be greater than or equal to", "")
+		},
+		"lt": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s must be before %s", fieldName(e), timeParamEN(e.Param()))
+			}
+			return sizeMessageEN(e, "must be shorter than", "must contain less than", "must be less than", "")
+		},
+		"lte": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s must be at or before %s", fieldName(e), timeParamEN(e.Param()))
+			}
+			return sizeMessageEN(e, "must be at most", "must contain at most", "must be less than or equal to", "")
+		},
+		"eq": func(e validator.FieldError) string {
+			if isCollection(e) {
+				return fmt.Sprintf("%s must contain exactly %s", fieldName(e), plural(e.Param(), "item"))
+			}
+			return fmt.Sprintf("%s must be equal to %s", fieldName(e), e.Param())
+		},
+		"eq_ignore_case": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be equal to %s (ignoring case)", fieldName(e), e.Param())
+		},
+		"ne": func(e validator.FieldError) string {
+			if isCollection(e) {
+				return fmt.Sprintf("%s must not contain exactly %s", fieldName(e), plural(e.Param(), "item"))
+			}
+			return fmt.Sprintf("%s must not be equal to %s", fieldName(e), e.Param())
+		},
+		"ne_ignore_case": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not be equal to %s (ignoring case)", fieldName(e), e.Param())
+		},
+		"oneof": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be one of: %s", fieldName(e), strings.Join(splitParams(e.Param()), ", "))
+		},
+		"oneofci": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be one of: %s (ignoring case)", fieldName(e), strings.Join(splitParams(e.Param()), ", "))
+		},
+		"unique": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must contain unique values", fieldName(e))
+		},
+
+		// cross-field comparisons
+		"eqfield":    fieldMessageEN("must be equal to"),
+		"eqcsfield":  fieldMessageEN("must be equal to"),
+		"nefield":    fieldMessageEN("must not be equal to"),
+		"necsfield":  fieldMessageEN("must not be equal to"),
+		"gtfield":    fieldMessageEN("must be greater than"),
+		"gtcsfield":  fieldMessageEN("must be greater than"),
+		"gtefield":   fieldMessageEN("must be greater than or equal to"),
+		"gtecsfield": fieldMessageEN("must be greater than or equal to"),
+		"ltfield":    fieldMessageEN("must be less than"),
+		"ltcsfield":  fieldMessageEN("must be less than"),
+		"ltefield":   fieldMessageEN("must be less than or equal to"),
+		"ltecsfield": fieldMessageEN("must be less than or equal to"),
+		"fieldcontains": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must contain the value of %s", fieldName(e), paramFieldName(e, e.Param()))
+		},
+		"fieldexcludes": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not contain the value of %s", fieldName(e), paramFieldName(e, e.Param()))
+		},
+
+		// string contents
+		"contains": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must contain the text '%s'", fieldName(e), e.Param())
+		},
+		"containsany": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must contain at least one of the characters '%s'", fieldName(e), e.Param())
+		},
+		"containsrune": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must contain the character '%s'", fieldName(e), e.Param())
+		},
+		"excludes": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not contain the text '%s'", fieldName(e), e.Param())
+		},
+		"excludesall": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not contain any of the characters '%s'", fieldName(e), e.Param())
+		},
+		"excludesrune": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not contain the character '%s'", fieldName(e), e.Param())
+		},
+		"startswith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must start with '%s'", fieldName(e), e.Param())
+		},
+		"startsnotwith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not start with '%s'", fieldName(e), e.Param())
+		},
+		"endswith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must end with '%s'", fieldName(e), e.Param())
+		},
+		"endsnotwith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must not end with '%s'", fieldName(e), e.Param())
+		},
+		"regexp": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s has an invalid format", fieldName(e))
+		},
+		"datetime": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be a valid datetime in the format %s", fieldName(e), e.Param())
+		},
+		"spicedb": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be a valid SpiceDB %s", fieldName(e), spiceDBNamesEN[e.Param()])
+		},
+	}
+
+	for tag, name := range formatNamesEN {
+		name := name
+		messages[tag] = func(e validator.FieldError) string {
+			return fmt.Sprintf("%s must be a valid %s", fieldName(e), name)
+		}
+	}
+	return messages
+}
+
+// sizeMessageEN builds the message of a size tag: the length of strings, the
+// number of items of collections, or the value of numbers.
+func sizeMessageEN(e validator.FieldError, stringVerb, collectionVerb, numberVerb, numberSuffix string) string {
+	switch {
+	case e.Kind() == reflect.String:
+		return fmt.Sprintf("%s %s %s long", fieldName(e), stringVerb, plural(e.Param(), "character"))
+	case isCollection(e):
+		return fmt.Sprintf("%s %s %s", fieldName(e), collectionVerb, plural(e.Param(), "item"))
+	}
+	return fmt.Sprintf("%s %s %s%s", fieldName(e), numberVerb, e.Param(), numberSuffix)
+}
+
+// fieldMessageEN builds the message of a cross-field tag.
+func fieldMessageEN(verb string) MessageFunc {
+	return func(e validator.FieldError) string {
+		return fmt.Sprintf("%s %s %s", fieldName(e), verb, paramFieldName(e, e.Param()))
+	}
+}
+
+// conditionsEN describes the "Field value" pairs of conditional tags, e.g. "Status is active".
+func conditionsEN(e validator.FieldError) string {
+	values := paramFieldNames(e, 2)
+	conditions := make([]string, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		conditions = append(conditions, fmt.Sprintf("%s is %s", values[i], values[i+1]))
+	}
+	return strings.Join(conditions, " and ")
+}
+
+// joinFields joins the fields named in a tag parameter with a conjunction, e.g. "email or phone".
+func joinFields(e validator.FieldError, conjunction string) string {
+	values := paramFieldNames(e, 1)
+	if len(values) <= 1 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " " + conjunction + " " + values[len(values)-1]
+}
+
+// splitParams splits a tag parameter into its values, removing the quotes of quoted values.
+func splitParams(param string) []string {
+	values := paramValues.FindAllString(param, -1)
+	for i, v := range values {
+		values[i] = strings.Trim(v, "'")
+	}
+	return values
+}
+
+// plural returns the count with the singular or plural form of noun, e.g. "1 character" or "8 characters".
+func plural(count, noun string) string {
+	if count == "1" {
+		return count + " " + noun
+	}
+	return count + " " + noun + "s"
+}
+
+// isCollection reports whether the validated field is a slice, an array or a map.
+func isCollection(e validator.FieldError) bool {
+	switch e.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
+
+// isTime reports whether the validated field is a time.Time.
+func isTime(e validator.FieldError) bool {
+	return e.Type() == timeType
+}
+
+// timeParamEN describes the parameter of a time comparison, which is the current time when empty.
+func timeParamEN(param string) string {
+	if param == "" {
+		return "the current time"
+	}
+	return param
+}
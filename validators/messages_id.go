@@ -0,0 +1,358 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// formatNamesID maps the format tags to the Indonesian name of the expected
+// format, used as "<field> harus berupa <name> yang valid".
+var formatNamesID = map[string]string{
+	"alpha":                         "teks alfabet",
+	"alphanum":                      "teks alfanumerik",
+	"alphaunicode":                  "teks alfabet unicode",
+	"alphanumunicode":               "teks alfanumerik unicode",
+	"ascii":                         "teks ASCII",
+	"printascii":                    "teks ASCII yang dapat dicetak",
+	"multibyte":                     "teks multibyte",
+	"boolean":                       "boolean",
+	"number":                        "angka",
+	"numeric":                       "nilai numerik",
+	"hexadecimal":                   "teks heksadesimal",
+	"lowercase":                     "teks huruf kecil",
+	"uppercase":                     "teks huruf besar",
+	"email":                         "alamat email",
+	"e164":                          "nomor telepon format E.164",
+	"url":                           "URL",
+	"http_url":                      "URL HTTP",
+	"uri":                           "URI",
+	"urn_rfc2141":                   "URN",
+	"datauri":                       "data URI",
+	"base64":                        "teks Base64",
+	"base64url":                     "teks Base64 URL",
+	"base64rawurl":                  "teks Base64 URL tanpa padding",
+	"base32":                        "teks Base32",
+	"json":                          "teks JSON",
+	"jwt":                           "JWT",
+	"html":                          "HTML",
+	"html_encoded":                  "teks HTML encoded",
+	"url_encoded":                   "teks URL encoded",
+	"uuid":                          "UUID",
+	"uuid3":                         "UUID versi 3",
+	"uuid4":                         "UUID versi 4",
+	"uuid5":                         "UUID versi 5",
+	"uuid_rfc4122":                  "UUID RFC 4122",
+	"uuid3_rfc4122":                 "UUID versi 3 RFC 4122",
+	"uuid4_rfc4122":                 "UUID versi 4 RFC 4122",
+	"uuid5_rfc4122":                 "UUID versi 5 RFC 4122",
+	"ulid":                          "ULID",
+	"md4":                           "hash MD4",
+	"md5":                           "hash MD5",
+	"sha256":                        "hash SHA256",
+	"sha384":                        "hash SHA384",
+	"sha512":                        "hash SHA512",
+	"ripemd128":                     "hash RIPEMD-128",
+	"ripemd160":                     "hash RIPEMD-160",
+	"tiger128":                      "hash TIGER128",
+	"tiger160":                      "hash TIGER160",
+	"tiger192":                      "hash TIGER192",
+	"hexcolor":                      "warna HEX",
+	"rgb":                           "warna RGB",
+	"rgba":                          "warna RGBA",
+	"hsl":                           "warna HSL",
+	"hsla":                          "warna HSLA",
+	"iscolor":                       "warna",
+	"isbn":                          "nomor ISBN",
+	"isbn10":                        "nomor ISBN-10",
+	"isbn13":                        "nomor ISBN-13",
+	"issn":                          "nomor ISSN",
+	"ssn":                           "nomor SSN",
+	"credit_card":                   "nomor kartu kredit",
+	"luhn_checksum":                 "angka dengan checksum Luhn",
+	"btc_addr":                      "alamat Bitcoin",
+	"btc_addr_bech32":               "alamat Bitcoin Bech32",
+	"eth_addr":                      "alamat Ethereum",
+	"eth_addr_checksum":             "alamat Ethereum dengan checksum",
+	"latitude":                      "garis lintang",
+	"longitude":                     "garis bujur",
+	"country_code":                  "kode negara",
+	"iso3166_1_alpha2":              "kode negara ISO 3166-1 alpha-2",
+	"iso3166_1_alpha3":              "kode negara ISO 3166-1 alpha-3",
+	"iso3166_1_alpha_numeric":       "kode negara numerik ISO 3166-1",
+	"eu_country_code":               "kode negara Uni Eropa",
+	"iso3166_1_alpha2_eu":           "kode negara Uni Eropa ISO 3166-1 alpha-2",
+	"iso3166_1_alpha3_eu":           "kode negara Uni Eropa ISO 3166-1 alpha-3",
+	"iso3166_1_alpha_numeric_eu":    "kode negara Uni Eropa numerik ISO 3166-1",
+	"iso3166_2":                     "kode subdivisi ISO 3166-2",
+	"iso4217":                       "kode mata uang ISO 4217",
+	"iso4217_numeric":               "kode mata uang numerik ISO 4217",
+	"bcp47_language_tag":            "tag bahasa BCP 47",
+	"bic":                           "kode BIC (SWIFT)",
+	"timezone":                      "zona waktu",
+	"semver":                        "versi semantik",
+	"cve":                           "ID CVE",
+	"cron":                          "ekspresi cron",
+	"mongodb":                       "ObjectID MongoDB",
+	"mongodb_connection_string":     "connection string MongoDB",
+	"postcode_iso3166_alpha2":       "kode pos",
+	"postcode_iso3166_alpha2_field": "kode pos",
+	"ip":                            "alamat IP",
+	"ipv4":                          "alamat IPv4",
+	"ipv6":                          "alamat IPv6",
+	"ip_addr":                       "alamat IP yang dapat di-resolve",
+	"ip4_addr":                      "alamat IPv4 yang dapat di-resolve",
+	"ip6_addr":                      "alamat IPv6 yang dapat di-resolve",
+	"cidr":                          "notasi CIDR",
+	"cidrv4":                        "notasi CIDR IPv4",
+	"cidrv6":                        "notasi CIDR IPv6",
+	"tcp_addr":                      "alamat TCP",
+	"tcp4_addr":                     "alamat TCPv4",
+	"tcp6_addr":                     "alamat TCPv6",
+	"udp_addr":                      "alamat UDP",
+	"udp4_addr":                     "alamat UDPv4",
+	"udp6_addr":                     "alamat UDPv6",
+	"unix_addr":                     "alamat Unix domain socket",
+	"mac":                           "alamat MAC",
+	"hostname":                      "hostname",
+	"hostname_rfc1123":              "hostname RFC 1123",
+	"dns_rfc1035_label":             "label DNS RFC 1035",
+	"hostname_port":                 "host dan port",
+	"fqdn":                          "nama domain lengkap (FQDN)",
+	"port":                          "nomor port",
+	"file":                          "path file yang ada",
+	"filepath":                      "path file",
+	"image":                         "file gambar",
+	"dir":                           "direktori yang ada",
+	"dirpath":                       "path direktori",
+}
+
+// spiceDBNamesID maps the parameters of the spicedb tag to the Indonesian name of the expected value.
+var spiceDBNamesID = map[string]string{
+	"":           "ID objek",
+	"id":         "ID objek",
+	"permission": "permission",
+	"type":       "tipe objek",
+}
+
+// builtinMessagesID returns the Indonesian messages of the go-playground built-in tags.
+func builtinMessagesID() map[string]MessageFunc {
+	messages := map[string]MessageFunc{
+		// presence
+		"required": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi", fieldName(e))
+		},
+		"required_if": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi jika %s", fieldName(e), conditionsID(e))
+		},
+		"required_unless": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi kecuali %s", fieldName(e), conditionsID(e))
+		},
+		"required_with": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi jika %s diisi", fieldName(e), joinFields(e, "atau"))
+		},
+		"required_with_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi jika %s diisi", fieldName(e), joinFields(e, "dan"))
+		},
+		"required_without": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi jika %s tidak diisi", fieldName(e), joinFields(e, "atau"))
+		},
+		"required_without_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi jika %s tidak diisi", fieldName(e), joinFields(e, "dan"))
+		},
+		"skip_unless": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s wajib diisi jika %s", fieldName(e), conditionsID(e))
+		},
+		"excluded_if": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong jika %s", fieldName(e), conditionsID(e))
+		},
+		"excluded_unless": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong kecuali %s", fieldName(e), conditionsID(e))
+		},
+		"excluded_with": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong jika %s diisi", fieldName(e), joinFields(e, "atau"))
+		},
+		"excluded_with_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong jika %s diisi", fieldName(e), joinFields(e, "dan"))
+		},
+		"excluded_without": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong jika %s tidak diisi", fieldName(e), joinFields(e, "atau"))
+		},
+		"excluded_without_all": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong jika %s tidak diisi", fieldName(e), joinFields(e, "dan"))
+		},
+		"isdefault": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus kosong", fieldName(e))
+		},
+
+		// size and value comparisons
+		"len": func(e validator.FieldError) string {
+			return sizeMessageID(e, "harus tepat", "harus berisi tepat", "harus sama dengan", "")
+		},
+		"min": func(e validator.FieldError) string {
+			return sizeMessageID(e, "minimal", "harus berisi minimal", "minimal", "")
+		},
+		"max": func(e validator.FieldError) string {
+			return sizeMessageID(e, "maksimal", "harus berisi maksimal", "maksimal", "")
+		},
+		"gt": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s harus setelah %s", fieldName(e), timeParamID(e.Param()))
+			}
+			return sizeMessageID(e, "harus lebih dari", "harus berisi lebih dari", "harus lebih besar dari", "")
+		},
+		"gte": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s harus sama dengan atau setelah %s", fieldName(e), timeParamID(e.Param()))
+			}
+			return sizeMessageID(e, "minimal", "harus berisi minimal", "harus lebih besar dari atau sama dengan", "")
+		},
+		"lt": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s harus sebelum %s", fieldName(e), timeParamID(e.Param()))
+			}
+			return sizeMessageID(e, "harus kurang dari", "harus berisi kurang dari", "harus lebih kecil dari", "")
+		},
+		"lte": func(e validator.FieldError) string {
+			if isTime(e) {
+				return fmt.Sprintf("%s harus sama dengan atau sebelum %s", fieldName(e), timeParamID(e.Param()))
+			}
+			return sizeMessageID(e, "maksimal", "harus berisi maksimal", "harus lebih kecil dari atau sama dengan", "")
+		},
+		"eq": func(e validator.FieldError) string {
+			if isCollection(e) {
+				return fmt.Sprintf("%s harus berisi tepat %s item", fieldName(e), e.Param())
+			}
+			return fmt.Sprintf("%s harus sama dengan %s", fieldName(e), e.Param())
+		},
+		"eq_ignore_case": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus sama dengan %s (tanpa membedakan huruf besar dan kecil)", fieldName(e), e.Param())
+		},
+		"ne": func(e validator.FieldError) string {
+			if isCollection(e) {
+				return fmt.Sprintf("%s tidak boleh berisi tepat %s item", fieldName(e), e.Param())
+			}
+			return fmt.Sprintf("%s tidak boleh sama dengan %s", fieldName(e), e.Param())
+		},
+		"ne_ignore_case": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh sama dengan %s (tanpa membedakan huruf besar dan kecil)", fieldName(e), e.Param())
+		},
+		"oneof": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus salah satu dari: %s", fieldName(e), strings.Join(splitParams(e.Param()), ", "))
+		},
+		"oneofci": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus salah satu dari: %s (tanpa membedakan huruf besar dan kecil)", fieldName(e), strings.Join(splitParams(e.Param()), ", "))
+		},
+		"unique": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berisi nilai yang unik", fieldName(e))
+		},
+
+		// cross-field comparisons
+		"eqfield":    fieldMessageID("harus sama dengan"),
+		"eqcsfield":  fieldMessageID("harus sama dengan"),
+		"nefield":    fieldMessageID("tidak boleh sama dengan"),
+		"necsfield":  fieldMessageID("tidak boleh sama dengan"),
+		"gtfield":    fieldMessageID("harus lebih besar dari"),
+		"gtcsfield":  fieldMessageID("harus lebih besar dari"),
+		"gtefield":   fieldMessageID("harus lebih besar dari atau sama dengan"),
+		"gtecsfield": fieldMessageID("harus lebih besar dari atau sama dengan"),
+		"ltfield":    fieldMessageID("harus lebih kecil dari"),
+		"ltcsfield":  fieldMessageID("harus lebih kecil dari"),
+		"ltefield":   fieldMessageID("harus lebih kecil dari atau sama dengan"),
+		"ltecsfield": fieldMessageID("harus lebih kecil dari atau sama dengan"),
+		"fieldcontains": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berisi nilai %s", fieldName(e), paramFieldName(e, e.Param()))
+		},
+		"fieldexcludes": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh berisi nilai %s", fieldName(e), paramFieldName(e, e.Param()))
+		},
+
+		// string contents
+		"contains": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berisi teks '%s'", fieldName(e), e.Param())
+		},
+		"containsany": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berisi minimal satu karakter dari '%s'", fieldName(e), e.Param())
+		},
+		"containsrune": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berisi karakter '%s'", fieldName(e), e.Param())
+		},
+		"excludes": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh berisi teks '%s'", fieldName(e), e.Param())
+		},
+		"excludesall": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh berisi karakter dari '%s'", fieldName(e), e.Param())
+		},
+		"excludesrune": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh berisi karakter '%s'", fieldName(e), e.Param())
+		},
+		"startswith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus diawali dengan '%s'", fieldName(e), e.Param())
+		},
+		"startsnotwith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh diawali dengan '%s'", fieldName(e), e.Param())
+		},
+		"endswith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus diakhiri dengan '%s'", fieldName(e), e.Param())
+		},
+		"endsnotwith": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s tidak boleh diakhiri dengan '%s'", fieldName(e), e.Param())
+		},
+		"regexp": func(e validator.FieldError) string {
+			return fmt.Sprintf("format %s tidak valid", fieldName(e))
+		},
+		"datetime": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berupa tanggal dan waktu yang valid dengan format %s", fieldName(e), e.Param())
+		},
+		"spicedb": func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berupa %s SpiceDB yang valid", fieldName(e), spiceDBNamesID[e.Param()])
+		},
+	}
+
+	for tag, name := range formatNamesID {
+		name := name
+		messages[tag] = func(e validator.FieldError) string {
+			return fmt.Sprintf("%s harus berupa %s yang valid", fieldName(e), name)
+		}
+	}
+	return messages
+}
+
+// sizeMessageID builds the Indonesian message of a size tag: the length of
+// strings, the number of items of collections, or the value of numbers.
+func sizeMessageID(e validator.FieldError, stringVerb, collectionVerb, numberVerb, numberSuffix string) string {
+	switch {
+	case e.Kind() == reflect.String:
+		return fmt.Sprintf("%s %s %s karakter", fieldName(e), stringVerb, e.Param())
+	case isCollection(e):
+		return fmt.Sprintf("%s %s %s item", fieldName(e), collectionVerb, e.Param())
+	}
+	return fmt.Sprintf("%s %s %s%s", fieldName(e), numberVerb, e.Param(), numberSuffix)
+}
+
+// fieldMessageID builds the Indonesian message of a cross-field tag.
+func fieldMessageID(verb string) MessageFunc {
+	return func(e validator.FieldError) string {
+		return fmt.Sprintf("%s %s %s", fieldName(e), verb, paramFieldName(e, e.Param()))
+	}
+}
+
+// conditionsID describes the "Field value" pairs of conditional tags in Indonesian, e.g. "Status adalah active".
+func conditionsID(e validator.FieldError) string {
+	values := paramFieldNames(e, 2)
+	conditions := make([]string, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		conditions = append(conditions, fmt.Sprintf("%s adalah %s", values[i], values[i+1]))
+	}
+	return strings.Join(conditions, " dan ")
+}
+
+// timeParamID describes the parameter of a time comparison in Indonesian, which is the current time when empty.
+func timeParamID(param string) string {
+	if param == "" {
+		return "waktu sekarang"
+	}
+	return param
+}
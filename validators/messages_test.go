@@ -0,0 +1,75 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type crossFieldItem struct {
+	Min int `json:"min"`
+	Max int `json:"max" validate:"gtfield=Min"`
+}
+
+type crossFieldRequest struct {
+	Kind            string           `json:"kind"`
+	Reason          string           `json:"reason" validate:"required_if=Kind refund"`
+	Password        string           `json:"password"`
+	ConfirmPassword string           `json:"confirm_password" label:"Password confirmation" validate:"eqfield=Password"`
+	Items           []crossFieldItem `json:"items" validate:"dive"`
+	Contact         struct {
+		Email string `json:"email" label:"Contact email"`
+	} `json:"contact"`
+	BackupEmail string `json:"backup_email" validate:"necsfield=Contact.Email"`
+}
+
+func TestCrossFieldMessages(t *testing.T) {
+	req := crossFieldRequest{
+		Kind:            "refund",
+		Password:        "secret",
+		ConfirmPassword: "other",
+		BackupEmail:     "a@example.com",
+	}
+	req.Items = []crossFieldItem{{Min: 5, Max: 1}}
+	req.Contact.Email = "a@example.com"
+
+	err := New().Struct(req)
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		t.Fatalf("Struct() error = %v, want validator.ValidationErrors", err)
+	}
+
+	want := map[string]map[string]string{
+		"reason": {
+			LangEN: "reason is required when kind is refund",
+			LangID: "reason wajib diisi jika kind adalah refund",
+		},
+		"confirm_password": {
+			LangEN: "Password confirmation must be equal to password",
+			LangID: "Password confirmation harus sama dengan password",
+		},
+		"items[0].max": {
+			LangEN: "items[0].max must be greater than items[0].min",
+			LangID: "items[0].max harus lebih besar dari items[0].min",
+		},
+		"backup_email": {
+			LangEN: "backup_email must not be equal to Contact email",
+			LangID: "backup_email tidak boleh sama dengan Contact email",
+		},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for _, e := range errs {
+		messages, ok := want[FieldPath(e)]
+		if !ok {
+			t.Errorf("unexpected error on %s: %v", FieldPath(e), e)
+			continue
+		}
+		for lang, msg := range messages {
+			if got := Message(lang, e); got != msg {
+				t.Errorf("Message(%s) of %s = %q, want %q", lang, FieldPath(e), got, msg)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	"github.com/go-playground/validator/v10"
+)
+
+// PasswordTag is the validation tag registered by RegisterPasswordValidation.
+const PasswordTag = "password"
+
+// ErrPasswordPolicyConflict is returned when a password tag is registered
+// again with another policy, since its messages are shared by every validator.
+var ErrPasswordPolicyConflict = errors.New("validators: password tag already registered with another policy")
+
+// passwordPolicies holds the policy bound to each password tag.
+var passwordPolicies = struct {
+	mu       sync.Mutex
+	policies map[string]help.PasswordPolicy
+}{policies: map[string]help.PasswordPolicy{}}
+
+// RegisterPasswordValidation registers the password tag, which checks a string
+// field against the policy, with English and Indonesian messages listing every
+// failed rule, e.g. "password must be at least 8 characters long, must contain a digit".
+//
+// A validator tag only sees its own field, so the user inputs of
+// PasswordPolicy.Check are passed as the names of sibling string fields,
+// separated by spaces:
+//
+//	type Register struct {
+//		Username string `json:"username" validate:"required"`
+//		Email    string `json:"email" validate:"required,email"`
+//		Password string `json:"password" validate:"required,password=Username Email"`
+//	}
+//
+// The messages list the failed user inputs too for the errors of Validate.
+// Messages are registered per tag, so the password tag is bound to a single
+// policy: use RegisterPasswordValidationTag for validators with other policies.
+//
+// Parameters:
+// - v: the validator to register the tag with.
+// - policy: the policy of the tag, e.g. help.DefaultPasswordPolicy().
+func RegisterPasswordValidation(v *validator.Validate, policy help.PasswordPolicy) error {
+	return RegisterPasswordValidationTag(v, PasswordTag, policy)
+}
+
+// RegisterPasswordValidationTag registers a password tag under another name,
+// e.g. admin_password with a stricter policy, see RegisterPasswordValidation.
+// It returns ErrPasswordPolicyConflict if the tag is bound to another policy.
+//
+// Parameters:
+// - v: the validator to register the tag with.
+// - tag: the name of the tag.
+// - policy: the policy of the tag.
+func RegisterPasswordValidationTag(v *validator.Validate, tag string, policy help.PasswordPolicy) error {
+	passwordPolicies.mu.Lock()
+	defer passwordPolicies.mu.Unlock()
+	if registered, ok := passwordPolicies.policies[tag]; ok && registered != policy {
+		return ErrPasswordPolicyConflict
+	}
+
+	err := v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+		return len(policy.Check(fl.Field().String(), passwordUserInputs(fl)...)) == 0
+	})
+	if err != nil {
+		return err
+	}
+
+	passwordPolicies.policies[tag] = policy
+	RegisterMessage(tag, passwordMessage(policy, help.LangEn))
+	RegisterMessageLang(LangID, tag, passwordMessage(policy, help.LangID))
+	return nil
+}
+
+// passwordMessage returns the MessageFunc of a password tag in the given language.
+func passwordMessage(policy help.PasswordPolicy, lang string) MessageFunc {
+	return func(e validator.FieldError) string {
+		password, _ := e.Value().(string)
+		inputs, known := fieldErrorUserInputs(e)
+		violations := policy.Check(password, inputs...)
+		if len(violations) == 0 && e.Param() != "" && !known {
+			// Without the sibling fields, only the user inputs can have failed.
+			violations = []help.PasswordViolation{{Rule: help.PasswordRuleUserInfo}}
+		}
+
+		messages := make([]string, len(violations))
+		for i, v := range violations {
+			messages[i] = v.Message(lang)
+		}
+		return fmt.Sprintf("%s %s", fieldName(e), strings.Join(messages, ", "))
+	}
+}
+
+// fieldErrorUserInputs returns the values of the sibling fields named in the
+// param of a password tag, and false when the error does not carry the
+// validated struct, i.e. it does not come from Validate.
+func fieldErrorUserInputs(e validator.FieldError) ([]string, bool) {
+	if e.Param() == "" {
+		return nil, true
+	}
+	fe, ok := e.(*FieldError)
+	if !ok || !fe.parentValue.IsValid() {
+		return nil, false
+	}
+	return structStringFields(fe.parentValue, strings.Fields(e.Param())), true
+}
+
+// passwordUserInputs returns the values of the sibling fields named in the param of the password tag.
+func passwordUserInputs(fl validator.FieldLevel) []string {
+	names := strings.Fields(fl.Param())
+	if len(names) == 0 {
+		return nil
+	}
+
+	return structStringFields(fl.Parent(), names)
+}
+
+// structStringFields returns the values of the named string fields of a struct value.
+func structStringFields(parent reflect.Value, names []string) []string {
+	parent = reflect.Indirect(parent)
+	if parent.Kind() != reflect.Struct {
+		return nil
+	}
+
+	inputs := make([]string, 0, len(names))
+	for _, name := range names {
+		field := reflect.Indirect(parent.FieldByName(name))
+		if field.Kind() == reflect.String {
+			inputs = append(inputs, field.String())
+		}
+	}
+	return inputs
+}
@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	"github.com/go-playground/validator/v10"
+)
+
+type passwordAccount struct {
+	Username string `json:"username"`
+	Password string `json:"password" validate:"password=Username"`
+}
+
+type passwordRequest struct {
+	Accounts []passwordAccount `json:"accounts" validate:"dive"`
+}
+
+func TestPasswordMessageUsesUserInputs(t *testing.T) {
+	v := New()
+	policy := help.PasswordPolicy{MinLength: 12}
+	if err := RegisterPasswordValidation(v.Validate, policy); err != nil {
+		t.Fatalf("RegisterPasswordValidation() error = %v", err)
+	}
+
+	err := v.Struct(passwordRequest{Accounts: []passwordAccount{{Username: "alice", Password: "alice"}}})
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Struct() error = %v, want one validation error", err)
+	}
+
+	want := "accounts[0].password must be at least 12 characters long, must not contain the username or email"
+	if got := Message(LangEN, errs[0]); got != want {
+		t.Errorf("Message() = %q, want %q", got, want)
+	}
+
+	if err := RegisterPasswordValidation(New().Validate, help.DefaultPasswordPolicy()); !errors.Is(err, ErrPasswordPolicyConflict) {
+		t.Errorf("RegisterPasswordValidation() with another policy error = %v, want ErrPasswordPolicyConflict", err)
+	}
+	if err := RegisterPasswordValidation(New().Validate, policy); err != nil {
+		t.Errorf("RegisterPasswordValidation() with the same policy error = %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package validators
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	help "github.com/adamnasrudin03/go-helpers"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldRule validates a single value with a fluent chain of rules, for inputs
+// that have no struct tags such as map[string]any from dynamic forms:
+//
+//	err := validators.Check(
+//		validators.Field("email", form["email"]).Required().Email().MaxLen(100),
+//		validators.Field("age", form["age"]).Min(18),
+//		validators.Field("website", form["website"]).Optional().URL(),
+//	)
+//
+// The rules report the same tags and messages as the struct tags, e.g. MaxLen
+// fails with the max tag, and check empty values like them: Min(18) fails for
+// 0 and Email for "". Once a rule fails, the next rules of the field are
+// skipped. Like omitempty, Optional skips the rules for empty values.
+type FieldRule struct {
+	name     string
+	label    string
+	value    interface{}
+	optional bool
+	err      *ruleError
+}
+
+// Field starts the rules of the value of a field.
+//
+// Parameters:
+// - name: the name of the field reported in the messages, e.g. "email".
+// - value: the value of the field.
+func Field(name string, value interface{}) *FieldRule {
+	return &FieldRule{name: name, value: value}
+}
+
+// Check runs the rules of every field and returns their failures as
+// validator.ValidationErrors, or nil when every rule passes. The result can be
+// formatted like the errors of struct tags, e.g. with response_mapper.FormatValidationError.
+func Check(fields ...*FieldRule) error {
+	var errs validator.ValidationErrors
+	for _, f := range fields {
+		if f.err != nil {
+			errs = append(errs, &FieldError{FieldError: f.err, path: f.name, label: f.label})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Err returns the failure of the field, or nil when every rule passes.
+func (f *FieldRule) Err() error {
+	return Check(f)
+}
+
+// Label sets the human-friendly name of the field used in the messages.
+func (f *FieldRule) Label(label string) *FieldRule {
+	f.label = label
+	return f
+}
+
+// Optional skips the rules of the field when the value is nil, zero or empty,
+// like the omitempty tag.
+func (f *FieldRule) Optional() *FieldRule {
+	f.optional = true
+	return f
+}
+
+// Required fails when the value is nil, zero or empty.
+func (f *FieldRule) Required() *FieldRule {
+	if f.err == nil && isEmpty(f.value) {
+		f.fail("required", "", kindOf(f.value))
+	}
+	return f
+}
+
+// Email fails when the value is not an email address.
+func (f *FieldRule) Email() *FieldRule {
+	return f.Func("email", "", help.IsEmail)
+}
+
+// URL fails when the value is not a URL.
+func (f *FieldRule) URL() *FieldRule {
+	return f.Func("url", "", func(s string) bool {
+		u, err := url.ParseRequestURI(s)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	})
+}
+
+// UUID fails when the value is not a UUID.
+func (f *FieldRule) UUID() *FieldRule {
+	return f.Func("uuid", "", help.IsUUID)
+}
+
+// Numeric fails when the value is not a number or a numeric string.
+func (f *FieldRule) Numeric() *FieldRule {
+	if f.skip() {
+		return f
+	}
+	if _, ok := toFloat(f.value); !ok {
+		f.fail("numeric", "", kindOf(f.value))
+	}
+	return f
+}
+
+// Matches fails when the value does not match the regular expression, with the tag "regexp".
+func (f *FieldRule) Matches(pattern *regexp.Regexp) *FieldRule {
+	return f.Func("regexp", pattern.String(), pattern.MatchString)
+}
+
+// OneOf fails when the value is not one of the given values.
+func (f *FieldRule) OneOf(values ...string) *FieldRule {
+	param := make([]string, len(values))
+	for i, v := range values {
+		param[i] = v
+		if strings.Contains(v, " ") {
+			param[i] = "'" + v + "'"
+		}
+	}
+
+	return f.Func("oneof", strings.Join(param, " "), func(s string) bool {
+		for _, v := range values {
+			if s == v {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Len fails when the string does not have exactly n characters, or the collection n items.
+func (f *FieldRule) Len(n int) *FieldRule {
+	return f.size("len", n, func(size int) bool { return size == n })
+}
+
+// MinLen fails when the string has less than n characters, or the collection less than n items.
+func (f *FieldRule) MinLen(n int) *FieldRule {
+	return f.size("min", n, func(size int) bool { return size >= n })
+}
+
+// MaxLen fails when the string has more than n characters, or the collection more than n items.
+func (f *FieldRule) MaxLen(n int) *FieldRule {
+	return f.size("max", n, func(size int) bool { return size <= n })
+}
+
+// Min fails when the number, or the numeric string, is less than n.
+func (f *FieldRule) Min(n float64) *FieldRule {
+	return f.number("min", n, func(v float64) bool { return v >= n })
+}
+
+// Max fails when the number, or the numeric string, is greater than n.
+func (f *FieldRule) Max(n float64) *FieldRule {
+	return f.number("max", n, func(v float64) bool { return v <= n })
+}
+
+// Func fails with the given tag when check returns false for the string value.
+// The message of the tag is taken from the message registry, see RegisterMessage.
+func (f *FieldRule) Func(tag, param string, check func(string) bool) *FieldRule {
+	if f.skip() {
+		return f
+	}
+	if s, ok := f.value.(string); !ok || !check(s) {
+		f.fail(tag, param, reflect.String)
+	}
+	return f
+}
+
+// size checks the length of a string or the number of items of a collection.
+func (f *FieldRule) size(tag string, n int, check func(int) bool) *FieldRule {
+	if f.skip() {
+		return f
+	}
+
+	kind := kindOf(f.value)
+	size := -1
+	switch kind {
+	case reflect.String:
+		size = utf8.RuneCountInString(reflect.ValueOf(f.value).String())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size = reflect.ValueOf(f.value).Len()
+	}
+	if size < 0 || !check(size) {
+		f.fail(tag, strconv.Itoa(n), kind)
+	}
+	return f
+}
+
+// number checks the value of a number or a numeric string.
+func (f *FieldRule) number(tag string, n float64, check func(float64) bool) *FieldRule {
+	if f.skip() {
+		return f
+	}
+	if v, ok := toFloat(f.value); !ok || !check(v) {
+		f.fail(tag, strconv.FormatFloat(n, 'f', -1, 64), reflect.Float64)
+	}
+	return f
+}
+
+// skip reports whether the next rules are skipped, after a failure or for an empty optional value.
+func (f *FieldRule) skip() bool {
+	return f.err != nil || (f.optional && isEmpty(f.value))
+}
+
+// fail records the failure of a rule.
+func (f *FieldRule) fail(tag, param string, kind reflect.Kind) {
+	f.err = &ruleError{
+		tag:   tag,
+		field: f.name,
+		value: f.value,
+		param: param,
+		kind:  kind,
+	}
+}
+
+// isEmpty reports whether a value is nil or the zero value of its type, or an empty collection.
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return v.IsZero()
+}
+
+// kindOf returns the kind of a value, dereferencing pointers.
+func kindOf(value interface{}) reflect.Kind {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v.Kind()
+}
+
+// toFloat converts a number or a numeric string to a float64.
+func toFloat(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// ruleError is the validator.FieldError of a failed FieldRule.
+type ruleError struct {
+	tag   string
+	field string
+	value interface{}
+	param string
+	kind  reflect.Kind
+}
+
+// Tag returns the tag of the failed rule, e.g. "max".
+func (e *ruleError) Tag() string { return e.tag }
+
+// ActualTag returns the tag of the failed rule.
+func (e *ruleError) ActualTag() string { return e.tag }
+
+// Namespace returns the name of the field.
+func (e *ruleError) Namespace() string { return e.field }
+
+// StructNamespace returns the name of the field.
+func (e *ruleError) StructNamespace() string { return e.field }
+
+// Field returns the name of the field.
+func (e *ruleError) Field() string { return e.field }
+
+// StructField returns the name of the field.
+func (e *ruleError) StructField() string { return e.field }
+
+// Value returns the validated value.
+func (e *ruleError) Value() interface{} { return e.value }
+
+// Param returns the parameter of the failed rule, e.g. "100".
+func (e *ruleError) Param() string { return e.param }
+
+// Kind returns the kind used to build the message, e.g. reflect.String for length rules on strings.
+func (e *ruleError) Kind() reflect.Kind { return e.kind }
+
+// Type returns the type of the validated value.
+func (e *ruleError) Type() reflect.Type { return reflect.TypeOf(e.value) }
+
+// Translate returns the English message of the failure, the rules do not use translators.
+func (e *ruleError) Translate(ut.Translator) string { return e.Error() }
+
+// Error returns the failure in the format of validator.FieldError.
+func (e *ruleError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.field, e.field, e.tag)
+}
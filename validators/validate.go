@@ -0,0 +1,282 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// labelTag is the struct tag holding the human-friendly name of a field.
+const labelTag = "label"
+
+// Validate is a validator.Validate that reports fields by their json names.
+//
+// The validation errors returned by its Struct methods are *FieldError values,
+// so messages use the label tag of the field, or its full json path such as
+// items[2].qty.
+type Validate struct {
+	*validator.Validate
+}
+
+// New creates a Validate with a tag name function for json tags.
+//
+// Parameters:
+// - options: the options of validator.New, e.g. validator.WithRequiredStructEnabled().
+func New(options ...validator.Option) *Validate {
+	v := validator.New(options...)
+	v.RegisterTagNameFunc(jsonTagName)
+	return &Validate{Validate: v}
+}
+
+// jsonTagName returns the json name of a struct field, or the field name when it has none.
+func jsonTagName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
+
+// Struct validates the exported fields of a struct, see validator.Validate.Struct.
+func (v *Validate) Struct(s interface{}) error {
+	return withPaths(s, v.Validate.Struct(s))
+}
+
+// StructCtx validates the exported fields of a struct, see validator.Validate.StructCtx.
+func (v *Validate) StructCtx(ctx context.Context, s interface{}) error {
+	return withPaths(s, v.Validate.StructCtx(ctx, s))
+}
+
+// StructPartial validates the given fields of a struct, see validator.Validate.StructPartial.
+func (v *Validate) StructPartial(s interface{}, fields ...string) error {
+	return withPaths(s, v.Validate.StructPartial(s, fields...))
+}
+
+// StructExcept validates the fields of a struct except the given ones, see validator.Validate.StructExcept.
+func (v *Validate) StructExcept(s interface{}, fields ...string) error {
+	return withPaths(s, v.Validate.StructExcept(s, fields...))
+}
+
+// FieldError is a validator.FieldError with the path and label of the field.
+type FieldError struct {
+	validator.FieldError
+	path   string
+	label  string
+	root   reflect.Type // root is the validated struct type.
+	parent reflect.Type // parent is the struct type holding the field.
+
+	parentValue reflect.Value // parentValue is the struct value holding the field, when it can be reached.
+}
+
+// Path returns the json path of the field without the struct name, e.g. items[2].qty.
+func (e *FieldError) Path() string {
+	return e.path
+}
+
+// Label returns the label tag of the field, or an empty string.
+func (e *FieldError) Label() string {
+	return e.label
+}
+
+// withPaths replaces the field errors of err with *FieldError values.
+func withPaths(s interface{}, err error) error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return err
+	}
+
+	rootType := reflect.TypeOf(s)
+	out := make(validator.ValidationErrors, len(errs))
+	for i, e := range errs {
+		fe := &FieldError{
+			FieldError: e,
+			path:       stripRoot(e.Namespace()),
+			root:       rootType,
+		}
+		if _, structPath, ok := strings.Cut(e.StructNamespace(), "."); ok {
+			segments := strings.Split(structPath, ".")
+			if field, parent, ok := structField(rootType, segments); ok {
+				fe.label = field.Tag.Get(labelTag)
+				fe.parent = parent
+				fe.parentValue = structParentValue(reflect.ValueOf(s), segments)
+			}
+		}
+		out[i] = fe
+	}
+	return out
+}
+
+// stripRoot removes the struct name from a namespace, e.g. Order.items[2].qty becomes items[2].qty.
+func stripRoot(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+	return namespace
+}
+
+// structField walks the struct path of a field, e.g. Items[2].Qty, from the
+// type t and returns the last field and the struct type holding it.
+func structField(t reflect.Type, segments []string) (reflect.StructField, reflect.Type, bool) {
+	var (
+		field  reflect.StructField
+		parent reflect.Type
+	)
+	for _, segment := range segments {
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			return reflect.StructField{}, nil, false
+		}
+
+		name, _, _ := strings.Cut(segment, "[")
+		var ok bool
+		if field, ok = t.FieldByName(name); !ok {
+			return reflect.StructField{}, nil, false
+		}
+		parent = t
+		t = field.Type
+
+		// Step into the elements of slices, arrays and maps for each index, e.g. Items[2].
+		for i := 0; i < strings.Count(segment, "["); i++ {
+			for t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				t = t.Elem()
+			}
+		}
+	}
+	return field, parent, parent != nil
+}
+
+// structParentValue walks the struct path of a field, e.g. Items[2].Qty, from
+// the value v and returns the struct value holding the field. Map keys other
+// than strings are not followed and return an invalid value.
+func structParentValue(v reflect.Value, segments []string) reflect.Value {
+	for _, segment := range segments[:len(segments)-1] {
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+
+		name, indexes, _ := strings.Cut(segment, "[")
+		v = v.FieldByName(name)
+		for _, index := range strings.Split(strings.TrimSuffix(indexes, "]"), "][") {
+			if index == "" {
+				continue
+			}
+			for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+				v = v.Elem()
+			}
+			switch v.Kind() {
+			case reflect.Slice, reflect.Array:
+				i, err := strconv.Atoi(index)
+				if err != nil || i < 0 || i >= v.Len() {
+					return reflect.Value{}
+				}
+				v = v.Index(i)
+			case reflect.Map:
+				if v.Type().Key().Kind() != reflect.String {
+					return reflect.Value{}
+				}
+				v = v.MapIndex(reflect.ValueOf(index).Convert(v.Type().Key()))
+			default:
+				return reflect.Value{}
+			}
+		}
+	}
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v
+}
+
+// FieldPath returns the path of the field of a validation error without the
+// struct name, e.g. items[2].qty for the errors of Validate.
+func FieldPath(e validator.FieldError) string {
+	if fe, ok := e.(*FieldError); ok && fe.path != "" {
+		return fe.path
+	}
+	return stripRoot(e.Namespace())
+}
+
+// fieldName returns the name of the field used in messages: its label, its
+// path for errors of Validate, or the field name of validator.FieldError.
+func fieldName(e validator.FieldError) string {
+	if fe, ok := e.(*FieldError); ok {
+		if fe.label != "" {
+			return fe.label
+		}
+		if fe.path != "" {
+			return fe.path
+		}
+	}
+	return e.Field()
+}
+
+// paramFieldName returns the name used in messages for a field named in the
+// parameter of a cross-field tag, e.g. Kind in required_if=Kind x: its label,
+// or its json path for errors of Validate. Other errors keep the Go name.
+func paramFieldName(e validator.FieldError, name string) string {
+	fe, ok := e.(*FieldError)
+	if !ok || fe.parent == nil {
+		return name
+	}
+
+	// The cs tags name a field from the root struct, e.g. Inner.Field, the others a sibling.
+	if strings.HasSuffix(e.Tag(), "csfield") {
+		segments := strings.Split(name, ".")
+		field, _, ok := structField(fe.root, segments)
+		if !ok {
+			return name
+		}
+		if label := field.Tag.Get(labelTag); label != "" {
+			return label
+		}
+		path := make([]string, len(segments))
+		t := fe.root
+		for i, segment := range segments {
+			f, _, _ := structField(t, []string{segment})
+			path[i] = jsonTagName(f)
+			t = f.Type
+		}
+		return strings.Join(path, ".")
+	}
+
+	field, ok := fe.parent.FieldByName(name)
+	if !ok {
+		return name
+	}
+	if label := field.Tag.Get(labelTag); label != "" {
+		return label
+	}
+	sibling := jsonTagName(field)
+	if i := strings.LastIndex(fe.path, "."); i >= 0 {
+		return fe.path[:i+1] + sibling
+	}
+	return sibling
+}
+
+// paramFieldNames returns the values of a tag parameter, with the field names
+// at the given stride resolved by paramFieldName, e.g. every other value of
+// required_if=Kind x Status y.
+func paramFieldNames(e validator.FieldError, stride int) []string {
+	values := splitParams(e.Param())
+	for i := 0; i < len(values); i += stride {
+		values[i] = paramFieldName(e, values[i])
+	}
+	return values
+}
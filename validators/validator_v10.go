@@ -1,8 +1,6 @@
 package validators
 
 import (
-	"fmt"
-
 	"github.com/go-playground/validator/v10"
 )
 
@@ -23,12 +21,12 @@ func FormatErrorValidator(errs validator.ValidationErrors) []string {
 	return msgEnUs
 }
 
-// FormatErrorValidatorSingle formats a single validation error message.
+// FormatErrorValidatorSingle formats a single validation error message in English.
 //
-// It takes a validator.FieldError object as input and returns a string representing the formatted error message.
-// The function extracts relevant information from the error object, including the validation tag, field name, validation parameter, and field type.
-// It then determines the appropriate error message based on the validation tag and constructs the message accordingly.
-// Additionally, if the error is related to a string field and a parameter is provided, the function appends "character" to the error message.
+// The message comes from the message registry, which covers every built-in
+// tag of go-playground/validator and the tags registered with RegisterMessage,
+// e.g. "Status must be one of: active, inactive". Unknown tags fall back to a
+// generic message naming the tag.
 //
 // Parameters:
 // - e: A validator.FieldError object containing information about the specific validation error.
@@ -36,41 +34,5 @@ func FormatErrorValidator(errs validator.ValidationErrors) []string {
 // Returns:
 // - A string containing the formatted error message based on the error details.
 func FormatErrorValidatorSingle(e validator.FieldError) string {
-	// Extract relevant information from the error object.
-	tag := e.Tag()               // Obtain the validation tag associated with the error.
-	field := e.Field()           // Retrieve the name of the field where the validation error occurred.
-	param := e.Param()           // Get the validation parameter provided in the error.
-	fieldType := e.Type().Name() // Determine the type of the field where the error occurred.
-
-	// Determine the error message based on the validation tag.
-	var msg string
-	switch tag {
-	case "required":
-		msg = fmt.Sprintf("%s is a required field", field)
-	case "email":
-		msg = fmt.Sprintf("%v must be an email address", field)
-	case "e164":
-		msg = fmt.Sprintf("%v must be a valid phone number in E.164 format", field)
-	case "gte":
-		msg = fmt.Sprintf("%v must be greater than or equal to %v", field, param)
-	case "lte":
-		msg = fmt.Sprintf("%v must be less than or equal to %v", field, param)
-	case "gt":
-		msg = fmt.Sprintf("%v must be greater than %v", field, param)
-	case "lt":
-		msg = fmt.Sprintf("%v must be less than %v", field, param)
-	case "eq":
-		msg = fmt.Sprintf("%v must be equal to %v", field, param)
-	case "eq_ignore_case":
-		msg = fmt.Sprintf("%v must be equal to %v (ignoring case)", field, param)
-	default:
-		msg = fmt.Sprintf("%v is %v %v", field, tag, param)
-	}
-
-	// Append "character" if the error is for a string field and a parameter is provided.
-	if param != "" && fieldType == "string" {
-		msg += " character"
-	}
-
-	return msg
+	return Message(LangEN, e)
 }